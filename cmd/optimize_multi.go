@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kaytu-io/kaytu/controller"
+	plugin2 "github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/runner"
+	"github.com/kaytu-io/kaytu/pkg/server"
+	"github.com/kaytu-io/kaytu/pkg/utils"
+	"github.com/kaytu-io/kaytu/preferences"
+	"github.com/kaytu-io/kaytu/view"
+	"github.com/spf13/cobra"
+	"os"
+	"strings"
+	"sync"
+)
+
+var optimizeAllCmd = &cobra.Command{
+	Use:   "all",
+	Short: "Run every optimize command of the installed plugins and show the results together",
+	Long: "Run every optimize command of the installed plugins and show the results together, grouped by command. " +
+		"Several commands can also be given to any optimize command, e.g. kaytu optimize ec2-instance rds-instance",
+	Args: cobra.NoArgs,
+	RunE: func(c *cobra.Command, args []string) error {
+		plugins, err := server.GetPlugins()
+		if err != nil {
+			return err
+		}
+
+		var commands []string
+		for _, plg := range plugins {
+			for _, cmd := range plg.Config.Commands {
+				if missing := missingRequiredFlag(c, cmd); missing != "" {
+					os.Stderr.WriteString(fmt.Sprintf("skipping %s, it requires --%s\n", cmd.Name, missing))
+					continue
+				}
+				commands = append(commands, cmd.Name)
+			}
+		}
+		if len(commands) == 0 {
+			return errors.New("no optimize commands to run, install a plugin with kaytu plugin install")
+		}
+		return runOptimizeCommands(c, commands)
+	},
+}
+
+type optimizeCommand struct {
+	plugin  *server.Plugin
+	command *golang.Command
+	session *runner.Session
+}
+
+// runOptimizeCommands runs several optimize commands at once, each one by its own plugin process registered in
+// a session of the same manager, and shows the results grouped by command
+func runOptimizeCommands(c *cobra.Command, names []string) error {
+	ctx := c.Context()
+
+	nonInteractiveFlag := utils.ReadStringFlag(c, "output")
+	switch nonInteractiveFlag {
+	case "interactive":
+	case "table":
+	case "csv":
+	case "json":
+	default:
+		return fmt.Errorf("output mode not recognized\npossible values: interactive, table, csv, json. default value: interactive (default \"interactive\")")
+	}
+
+	if utils.ReadBooleanFlag(c, "plugin-debug-mode") {
+		return errors.New("plugin debug mode can only be used with a single optimize command")
+	}
+	if utils.ReadStringFlag(c, "record") != "" {
+		return errors.New("--record can only be used with a single optimize command")
+	}
+
+	plugins, err := server.GetPlugins()
+	if err != nil {
+		return err
+	}
+	var commands []*optimizeCommand
+	seen := map[string]bool{}
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		var found *optimizeCommand
+		for _, plg := range plugins {
+			for _, cmd := range plg.Config.Commands {
+				if cmd.Name == name {
+					found = &optimizeCommand{plugin: plg, command: cmd}
+				}
+			}
+		}
+		if found == nil {
+			return fmt.Errorf("unknown optimize command %s", name)
+		}
+		if missing := missingRequiredFlag(c, found.command); missing != "" {
+			return fmt.Errorf("%s requires --%s, run it on its own to set it", name, missing)
+		}
+		commands = append(commands, found)
+	}
+
+	// the preferences of all the commands are shown together, each one is sent its own
+	values, err := readPreferencesFile(c)
+	if err != nil {
+		return err
+	}
+
+	manager := plugin2.New()
+	manager.SetRestartPolicy(int(utils.ReadIntFlag(c, "plugin-restarts")))
+	manager.SetTimeouts(utils.ReadDurationFlag(c, "plugin-timeout"), 0)
+	// the interactive view keeps the plugin logs from the start for its logs page
+	var logLevel golang.LogLevel
+	var logsController *controller.Logs
+	if nonInteractiveFlag != "interactive" {
+		logLevel, err = readLogLevel(c)
+		if err != nil {
+			return err
+		}
+	} else {
+		logsController = controller.NewLogs()
+		manager.SetLogs(logsController)
+	}
+	err = manager.StartServer()
+	if err != nil {
+		return err
+	}
+	defer manager.StopServer()
+	onShutdown(func() {
+		manager.Shutdown("interrupted", plugin2.ShutdownGracePeriod)
+	})
+
+	// plugins running several of the commands are updated once
+	updated := map[string]bool{}
+	for _, oc := range commands {
+		opts := runOptions(c, nil, oc.plugin.Config.Name, oc.command, false)
+		opts.Output = nonInteractiveFlag
+		opts.AgentMode = utils.ReadBooleanFlag(c, "agent-mode")
+		opts.LogLevel = logLevel
+		opts.Preferences = values
+		opts.SkipUpdate = updated[oc.plugin.Config.Name]
+		updated[oc.plugin.Config.Name] = true
+
+		oc.session, err = runner.StartSession(ctx, manager, opts)
+		if err != nil {
+			return fmt.Errorf("failed to start %s: %v", oc.command.Name, err)
+		}
+		defer oc.session.Close()
+	}
+	err = preferences.UpdateValues(values)
+	if err != nil {
+		return err
+	}
+
+	var app pageChanger
+	var jobsController *controller.Jobs
+	if nonInteractiveFlag == "interactive" {
+		app, jobsController = newSourcesApp(manager, commands, logsController)
+	}
+
+	for _, oc := range commands {
+		err = oc.session.Send(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if nonInteractiveFlag != "interactive" {
+		return showCombinedResults(commands, nonInteractiveFlag)
+	}
+
+	go checkForLimitsError(app, jobsController)
+	setColorProfile(c)
+	p := tea.NewProgram(app, tea.WithFPS(10))
+	_, err = p.Run()
+	manager.Shutdown("user quit", plugin2.ShutdownGracePeriod)
+	return err
+}
+
+// newSourcesApp builds the interactive view of the commands. The commands shown with the default charts share
+// one App and group their results by command, each command using its own charts gets an App of its own.
+func newSourcesApp(manager *plugin2.Manager, commands []*optimizeCommand, logsController *controller.Logs) (pageChanger, *controller.Jobs) {
+	helpController := controller.NewHelp()
+
+	jobsController := controller.NewJobs()
+	statusBar := view.NewStatusBarView(jobsController, helpController, logsController)
+	jobsPage := view.NewJobsPage(jobsController, helpController, statusBar)
+	contactUsPage := view.NewContactUsPage(helpController)
+	logsPage := view.NewLogsPage(logsController, helpController, statusBar)
+
+	sourcesApp := view.NewSourcesApp()
+	var apps []*view.App
+	var defaultSources []string
+	var defaultOptimizations *controller.Optimizations[golang.OptimizationItem]
+	for _, oc := range commands {
+		if !oc.session.Plugin.UsesCustomCharts() {
+			defaultSources = append(defaultSources, oc.command.Name)
+		}
+	}
+	if len(defaultSources) > 0 {
+		defaultOptimizations = controller.NewOptimizations[golang.OptimizationItem]()
+		optimizationsPage := view.NewOptimizationsView(defaultOptimizations, helpController, statusBar)
+		optimizationsDetailsPage := view.NewOptimizationDetailsView(defaultOptimizations, helpController, statusBar)
+		preferencesPage := view.NewPreferencesConfiguration(helpController, defaultOptimizations, statusBar)
+		app := view.NewApp(
+			optimizationsPage,
+			optimizationsDetailsPage,
+			preferencesPage,
+			jobsPage,
+			contactUsPage,
+			logsPage,
+		)
+		apps = append(apps, app)
+		sourcesApp.Add(strings.Join(defaultSources, ", "), app)
+	}
+
+	for _, oc := range commands {
+		if !oc.session.Plugin.UsesCustomCharts() {
+			continue
+		}
+		config := oc.session.Plugin.Plugin.Config
+		optimizationsController := controller.NewOptimizations[golang.ChartOptimizationItem]()
+		optimizationsPage := view.NewPluginCustomOverviewPageView(config.OverviewChart, optimizationsController, helpController, statusBar)
+		optimizationsDetailsPage := view.NewPluginCustomOptimizationDetailsView(config.DevicesChart, optimizationsController, helpController, statusBar)
+		preferencesPage := view.NewPreferencesConfiguration(helpController, optimizationsController, statusBar)
+		oc.session.Manager.SetCustomUI(jobsController, optimizationsController, &optimizationsPage, &optimizationsDetailsPage)
+		app := view.NewCustomPluginApp(
+			&optimizationsPage,
+			&optimizationsDetailsPage,
+			preferencesPage,
+			jobsPage,
+			contactUsPage,
+			logsPage,
+		)
+		apps = append(apps, app)
+		sourcesApp.Add(oc.command.Name, app)
+	}
+
+	// set after the custom uis, the sessions having one keep it
+	if defaultOptimizations != nil {
+		manager.SetDefaultUI(jobsController, defaultOptimizations)
+	}
+
+	if len(apps) == 1 {
+		return apps[0], jobsController
+	}
+	return sourcesApp, jobsController
+}
+
+// showCombinedResults waits for all the commands to finish and prints their results together, commands that
+// failed are reported on stderr and left out
+func showCombinedResults(commands []*optimizeCommand, format string) error {
+	results := make([]view.SourceResult, len(commands))
+	errs := make([]error, len(commands))
+	var wg sync.WaitGroup
+	for idx, oc := range commands {
+		wg.Add(1)
+		go func(idx int, oc *optimizeCommand) {
+			defer wg.Done()
+			result, err := oc.session.Manager.NonInteractiveView.WaitAndReturnResults(format)
+			results[idx] = view.SourceResult{
+				Plugin:  oc.plugin.Config.Name,
+				Command: oc.command.Name,
+				Result:  result,
+			}
+			errs[idx] = err
+		}(idx, oc)
+	}
+	wg.Wait()
+
+	var succeeded []view.SourceResult
+	var failed error
+	for idx, err := range errs {
+		if err != nil {
+			os.Stderr.WriteString(fmt.Sprintf("%s failed: %v\n", commands[idx].command.Name, err))
+			failed = fmt.Errorf("%d of %d commands failed", countErrors(errs), len(commands))
+			continue
+		}
+		succeeded = append(succeeded, results[idx])
+	}
+
+	out, err := view.CombineResults(format, succeeded)
+	if err != nil {
+		return err
+	}
+	os.Stdout.WriteString(out)
+	return failed
+}
+
+func countErrors(errs []error) int {
+	count := 0
+	for _, err := range errs {
+		if err != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// missingRequiredFlag returns the name of a required flag of the command without a default that can't be read
+// from the invoked command
+func missingRequiredFlag(c *cobra.Command, cmd *golang.Command) string {
+	for _, flag := range cmd.GetFlags() {
+		if !flag.Required || flag.Default != "" {
+			continue
+		}
+		if f := c.Flags().Lookup(flag.Name); f == nil || f.Value.String() == "" {
+			return flag.Name
+		}
+	}
+	return ""
+}
@@ -16,8 +16,36 @@ func init() {
 	PluginCmd.AddCommand(installCmd)
 	PluginCmd.AddCommand(uninstallCmd)
 	PluginCmd.AddCommand(listCmd)
+	PluginCmd.AddCommand(pinCmd)
+	PluginCmd.AddCommand(unpinCmd)
+	PluginCmd.AddCommand(rollbackCmd)
+	PluginCmd.AddCommand(outdatedCmd)
+	PluginCmd.AddCommand(updateCmd)
+	PluginCmd.AddCommand(autoUpdateCmd)
+	PluginCmd.AddCommand(infoCmd)
+	PluginCmd.AddCommand(devCmd)
 
 	installCmd.Flags().String("token", "", "Github fine-grained access token")
-	installCmd.Flags().Bool("unsafe", false, "Allow kaytu to install unapproved plugins")
+	installCmd.Flags().Bool("unsafe", false, "Allow kaytu to install unapproved or unverified plugins")
+	installCmd.Flags().String("from-file", "", "Install the plugin from a local binary, release archive or directory of release assets")
 	installCmd.Flags().Bool("plugin-debug-mode", false, "Enable plugin debug mode (manager wont start plugin)")
+
+	outdatedCmd.Flags().String("output", "table", "Output format (possible values: table, json)")
+	outdatedCmd.Flags().String("token", "", "Github fine-grained access token")
+
+	updateCmd.Flags().Bool("all", false, "Update all installed plugins")
+	updateCmd.Flags().String("token", "", "Github fine-grained access token")
+	updateCmd.Flags().Bool("unsafe", false, "Allow kaytu to install unapproved or unverified plugins")
+
+	listCmd.Flags().String("output", "interactive", "Output format (possible values: interactive, table, json, yaml, csv)")
+	listCmd.Flags().Bool("check-updates", false, "Look up the latest release of each plugin to report outdated plugins")
+	listCmd.Flags().String("token", "", "Github fine-grained access token")
+
+	infoCmd.Flags().String("output", "table", "Output format (possible values: table, json, yaml)")
+
+	devCmd.Flags().String("output", "table", "Output format (possible values: table, csv, json)")
+	devCmd.Flags().StringArray("flag", nil, "Flag sent to the plugin command as key=value, can be repeated")
+	devCmd.Flags().String("log-level", "info", "Lowest level of the plugin logs to show (possible values: debug, info, warn, error)")
+
+	rollbackCmd.Flags().String("to", "", "Version to roll back to (default: the newest version older than the installed one)")
 }
@@ -0,0 +1,253 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"github.com/kaytu-io/kaytu/controller"
+	"github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
+	"github.com/kaytu-io/kaytu/pkg/server"
+	"github.com/kaytu-io/kaytu/pkg/utils"
+	"github.com/kaytu-io/kaytu/preferences"
+	"github.com/spf13/cobra"
+	"os"
+	"strings"
+	"time"
+)
+
+// devPollInterval is how often the plugin source is checked for changes
+const devPollInterval = 500 * time.Millisecond
+
+var devCmd = &cobra.Command{
+	Use:   "dev <path> [command]",
+	Short: "Build a plugin from source, run a command with it and rerun it when the source changes",
+	Long: "Build the plugin in the given directory with go build, run the optimize command with it and show the " +
+		"results. The source is watched for changes, on a change the plugin is rebuilt, restarted and sent the same " +
+		"command again. The command can be left out if the plugin has only one.",
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		output := utils.ReadStringFlag(cmd, "output")
+		switch output {
+		case "table", "csv", "json":
+		default:
+			return fmt.Errorf("output mode not recognized\npossible values: table, csv, json")
+		}
+		flagValues, err := cmd.Flags().GetStringArray("flag")
+		if err != nil {
+			return err
+		}
+		commandName := ""
+		if len(args) > 1 {
+			commandName = args[1]
+		}
+		logLevel, err := sdk.ParseLogLevel(utils.ReadStringFlag(cmd, "log-level"))
+		if err != nil {
+			return err
+		}
+
+		manager := plugin.New()
+		manager.SetNonInteractiveView(false)
+		manager.SetLogLevel(logLevel)
+		err = manager.StartServer()
+		if err != nil {
+			return err
+		}
+		defer manager.StopServer()
+
+		dev, err := manager.NewDevSession(args[0])
+		if err != nil {
+			return err
+		}
+		defer dev.Close()
+
+		d := devRunner{manager: manager, dev: dev, command: commandName, flagValues: flagValues, output: output}
+		for {
+			err = d.run(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				os.Stderr.WriteString(err.Error() + "\n")
+			}
+			if d.changed {
+				continue
+			}
+
+			os.Stderr.WriteString("Waiting for changes...\n")
+			err = d.waitForChange(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+		}
+	},
+}
+
+// devRunner runs the command with the plugin built from source, once for each change of the source
+type devRunner struct {
+	manager    *plugin.Manager
+	dev        *plugin.DevSession
+	command    string
+	flagValues []string
+	output     string
+
+	// changed tells the source changed while the command was running, it's rerun right away
+	changed bool
+}
+
+// run builds and restarts the plugin and shows the results of the command. The command is sent the first time,
+// afterwards the last one sent is replayed.
+func (d *devRunner) run(ctx context.Context) error {
+	d.changed = false
+	os.Stderr.WriteString("Building the plugin...\n")
+	err := d.dev.Build(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the plugin run before is stopped before its view is replaced, the results it didn't send are dropped
+	d.dev.Stop()
+	d.manager.SetNonInteractiveView(false)
+	running, err := d.dev.Start(ctx)
+	if err != nil {
+		return err
+	}
+	config := running.Plugin.Config
+	if running.UsesCustomCharts() {
+		d.manager.NonInteractiveView.SetOptimizations(nil, controller.NewOptimizations[golang.ChartOptimizationItem](),
+			config.OverviewChart, config.DevicesChart)
+	} else {
+		d.manager.NonInteractiveView.SetOptimizations(controller.NewOptimizations[golang.OptimizationItem](),
+			nil, nil, nil)
+	}
+	os.Stderr.WriteString(fmt.Sprintf("Plugin %s %s started\n", config.Name, config.Version))
+
+	replayed, err := d.dev.Replay()
+	if err != nil {
+		return err
+	}
+	if !replayed {
+		err = d.start(running)
+		if err != nil {
+			return err
+		}
+	}
+
+	results := make(chan error, 1)
+	view := d.manager.NonInteractiveView
+	go func() {
+		results <- view.WaitForResults()
+	}()
+
+	ticker := time.NewTicker(devPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-results:
+			if err != nil {
+				return err
+			}
+			out, err := view.RenderResults(d.output)
+			if err != nil {
+				return err
+			}
+			os.Stderr.WriteString(fmt.Sprintf("Results of %s at %s:\n", d.command, time.Now().Format(time.TimeOnly)))
+			fmt.Println(out)
+			for _, failed := range view.FailedJobs() {
+				os.Stderr.WriteString(failed + "\n")
+			}
+			return nil
+		case <-ticker.C:
+			changed, err := d.dev.Changed()
+			if err != nil {
+				return err
+			}
+			if changed {
+				os.Stderr.WriteString("Source changed, rebuilding...\n")
+				d.changed = true
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// start sends the command to the plugin the first time it's run, with the default preferences of the command
+func (d *devRunner) start(running *plugin.RunningPlugin) error {
+	var command *golang.Command
+	for _, c := range running.Plugin.Config.Commands {
+		if c.Name == d.command || (d.command == "" && len(running.Plugin.Config.Commands) == 1) {
+			command = c
+		}
+	}
+	if command == nil {
+		var names []string
+		for _, c := range running.Plugin.Config.Commands {
+			names = append(names, c.Name)
+		}
+		if d.command == "" {
+			return fmt.Errorf("the plugin has several commands, pass one of: %s", strings.Join(names, ", "))
+		}
+		return fmt.Errorf("unknown command %s, the plugin has: %s", d.command, strings.Join(names, ", "))
+	}
+	d.command = command.Name
+
+	given := map[string]string{}
+	for _, kv := range d.flagValues {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			return fmt.Errorf("invalid --flag %s, expected key=value", kv)
+		}
+		given[key] = value
+	}
+	flags := map[string]string{"output": d.output}
+	for _, flag := range command.GetFlags() {
+		value, ok := given[flag.Name]
+		if !ok {
+			value = flag.Default
+		}
+		if flag.Required && value == "" {
+			return fmt.Errorf("%s requires the %s flag, pass it with --flag %s=<value>", command.Name, flag.Name, flag.Name)
+		}
+		flags[flag.Name] = value
+	}
+
+	cfg, err := server.GetConfig()
+	if err != nil {
+		return err
+	}
+	preferences.Update(command.DefaultPreferences)
+	return d.manager.SendStart(running.Plugin.Config.Name, &golang.StartProcess{
+		Command:            command.Name,
+		Flags:              flags,
+		KaytuAccessToken:   cfg.AccessToken,
+		DefaultPreferences: preferences.DefaultPreferences(),
+	})
+}
+
+// waitForChange polls the plugin source until it changes
+func (d *devRunner) waitForChange(ctx context.Context) error {
+	ticker := time.NewTicker(devPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			changed, err := d.dev.Changed()
+			if err != nil {
+				return err
+			}
+			if changed {
+				os.Stderr.WriteString("Source changed, rebuilding...\n")
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/utils"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type pluginInfo struct {
+	Name             string        `json:"name" yaml:"name"`
+	Version          string        `json:"version" yaml:"version"`
+	Provider         string        `json:"provider" yaml:"provider"`
+	MinKaytuVersion  string        `json:"minKaytuVersion,omitempty" yaml:"minKaytuVersion,omitempty"`
+	CustomCharts     bool          `json:"customCharts" yaml:"customCharts"`
+	ProtocolVersion  uint32        `json:"protocolVersion" yaml:"protocolVersion"`
+	Capabilities     []string      `json:"capabilities,omitempty" yaml:"capabilities,omitempty"`
+	Pinned           bool          `json:"pinned" yaml:"pinned"`
+	InstalledVersion string        `json:"installedVersion,omitempty" yaml:"installedVersion,omitempty"`
+	Commands         []commandInfo `json:"commands" yaml:"commands"`
+	RootCommands     []commandInfo `json:"rootCommands,omitempty" yaml:"rootCommands,omitempty"`
+}
+
+type commandInfo struct {
+	Name          string           `json:"name" yaml:"name"`
+	Description   string           `json:"description" yaml:"description"`
+	LoginRequired bool             `json:"loginRequired" yaml:"loginRequired"`
+	Flags         []flagInfo       `json:"flags,omitempty" yaml:"flags,omitempty"`
+	Preferences   []preferenceInfo `json:"defaultPreferences,omitempty" yaml:"defaultPreferences,omitempty"`
+}
+
+type flagInfo struct {
+	Name        string `json:"name" yaml:"name"`
+	Default     string `json:"default,omitempty" yaml:"default,omitempty"`
+	Description string `json:"description" yaml:"description"`
+	Required    bool   `json:"required" yaml:"required"`
+}
+
+type preferenceInfo struct {
+	Service        string   `json:"service" yaml:"service"`
+	Key            string   `json:"key" yaml:"key"`
+	Alias          string   `json:"alias,omitempty" yaml:"alias,omitempty"`
+	Value          *string  `json:"value,omitempty" yaml:"value,omitempty"`
+	PossibleValues []string `json:"possibleValues,omitempty" yaml:"possibleValues,omitempty"`
+	Unit           string   `json:"unit,omitempty" yaml:"unit,omitempty"`
+	IsNumber       bool     `json:"isNumber" yaml:"isNumber"`
+	Pinned         bool     `json:"pinned" yaml:"pinned"`
+	PreventPinning bool     `json:"preventPinning" yaml:"preventPinning"`
+}
+
+func toCommandInfo(commands []*golang.Command) []commandInfo {
+	var result []commandInfo
+	for _, c := range commands {
+		info := commandInfo{
+			Name:          c.Name,
+			Description:   c.Description,
+			LoginRequired: c.LoginRequired,
+		}
+		for _, f := range c.Flags {
+			info.Flags = append(info.Flags, flagInfo{
+				Name:        f.Name,
+				Default:     f.Default,
+				Description: f.Description,
+				Required:    f.Required,
+			})
+		}
+		for _, p := range c.DefaultPreferences {
+			pref := preferenceInfo{
+				Service:        p.Service,
+				Key:            p.Key,
+				Alias:          p.Alias,
+				PossibleValues: p.PossibleValues,
+				Unit:           p.Unit,
+				IsNumber:       p.IsNumber,
+				Pinned:         p.Pinned,
+				PreventPinning: p.PreventPinning,
+			}
+			if p.Value != nil {
+				value := p.Value.GetValue()
+				pref.Value = &value
+			}
+			info.Preferences = append(info.Preferences, pref)
+		}
+		result = append(result, info)
+	}
+	return result
+}
+
+var infoCmd = &cobra.Command{
+	Use:   "info <plugin>",
+	Short: "Show the commands, flags and default preferences of an installed plugin",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return errors.New("please provide plugin name")
+		}
+
+		output := utils.ReadStringFlag(cmd, "output")
+		if output != "table" && output != "json" && output != "yaml" {
+			return fmt.Errorf("output mode not recognized\npossible values: table, json, yaml")
+		}
+
+		plg, err := plugin.InstalledPlugin(args[0])
+		if err != nil {
+			return err
+		}
+
+		var capabilities []string
+		for _, c := range plg.Config.Capabilities {
+			capabilities = append(capabilities, strings.ToLower(strings.TrimPrefix(c.String(), "CAPABILITY_")))
+		}
+
+		info := pluginInfo{
+			Name:             plg.Config.Name,
+			Version:          plg.Config.Version,
+			Provider:         plg.Config.Provider,
+			MinKaytuVersion:  plg.Config.MinKaytuVersion,
+			CustomCharts:     plugin.ShowsCustomCharts(plg),
+			Pinned:           plg.Pinned,
+			ProtocolVersion:  plg.Config.ProtocolVersion,
+			Capabilities:     capabilities,
+			InstalledVersion: plg.InstalledVersion,
+			Commands:         toCommandInfo(plg.Config.Commands),
+			RootCommands:     toCommandInfo(plg.Config.RootCommands),
+		}
+
+		switch output {
+		case "json":
+			out, err := json.MarshalIndent(info, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+			return nil
+		case "yaml":
+			out, err := yaml.Marshal(info)
+			if err != nil {
+				return err
+			}
+			fmt.Print(string(out))
+			return nil
+		}
+
+		printPluginInfo(info)
+		return nil
+	},
+}
+
+func printPluginInfo(info pluginInfo) {
+	minKaytuVersion := info.MinKaytuVersion
+	if minKaytuVersion == "" {
+		minKaytuVersion = "-"
+	}
+	fmt.Printf("Name:              %s\n", info.Name)
+	fmt.Printf("Version:           %s\n", info.Version)
+	fmt.Printf("Provider:          %s\n", info.Provider)
+	fmt.Printf("Min Kaytu Version: %s\n", minKaytuVersion)
+	fmt.Printf("Custom Charts:     %s\n", strconv.FormatBool(info.CustomCharts))
+	fmt.Printf("Pinned:            %s\n", strconv.FormatBool(info.Pinned))
+	fmt.Printf("Protocol Version:  %d\n", info.ProtocolVersion)
+	if len(info.Capabilities) > 0 {
+		fmt.Printf("Capabilities:      %s\n", strings.Join(info.Capabilities, ", "))
+	}
+
+	printCommands("Commands", info.Commands)
+	printCommands("Root Commands", info.RootCommands)
+}
+
+func printCommands(title string, commands []commandInfo) {
+	if len(commands) == 0 {
+		return
+	}
+
+	fmt.Printf("\n%s:\n", title)
+	for _, c := range commands {
+		loginRequired := ""
+		if c.LoginRequired {
+			loginRequired = " (login required)"
+		}
+		fmt.Printf("\n  %s%s\n    %s\n", c.Name, loginRequired, c.Description)
+
+		if len(c.Flags) > 0 {
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.SetStyle(table.StyleLight)
+			t.AppendHeader(table.Row{"Flag", "Required", "Default", "Description"})
+			for _, f := range c.Flags {
+				required := ""
+				if f.Required {
+					required = "*"
+				}
+				t.AppendRow(table.Row{"--" + f.Name, required, f.Default, f.Description})
+			}
+			t.Render()
+		}
+
+		if len(c.Preferences) > 0 {
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.SetStyle(table.StyleLight)
+			t.AppendHeader(table.Row{"Service", "Preference", "Default", "Possible Values", "Unit", "Pinned"})
+			for _, p := range c.Preferences {
+				name := p.Key
+				if p.Alias != "" {
+					name = p.Alias
+				}
+				value := "Any"
+				if p.Value != nil {
+					value = *p.Value
+				}
+				t.AppendRow(table.Row{p.Service, name, value, strings.Join(p.PossibleValues, ", "), p.Unit, strconv.FormatBool(p.Pinned)})
+			}
+			t.Render()
+		}
+	}
+}
@@ -10,7 +10,8 @@ import (
 )
 
 var installCmd = &cobra.Command{
-	Use: "install",
+	Use:   "install <plugin>[@version]",
+	Short: "Install a plugin, installing a specific version pins the plugin to it",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
@@ -26,6 +27,20 @@ var installCmd = &cobra.Command{
 			return err
 		}
 
+		fromFile := utils.ReadStringFlag(cmd, "from-file")
+		if fromFile != "" {
+			addr := ""
+			if len(args) > 0 {
+				addr = args[0]
+			}
+			err = manager.InstallFromFile(ctx, addr, fromFile)
+			if err != nil {
+				os.Stderr.WriteString(fmt.Sprintf("failed to install plugin due to %v\n", err))
+				return err
+			}
+			return nil
+		}
+
 		if len(args) == 0 {
 			return errors.New("please provide plugin path")
 		}
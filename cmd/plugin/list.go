@@ -1,12 +1,21 @@
 package plugin
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/evertras/bubble-table/table"
+	prettyTable "github.com/jedib0t/go-pretty/v6/table"
+	"github.com/kaytu-io/kaytu/pkg/plugin"
 	"github.com/kaytu-io/kaytu/pkg/server"
 	"github.com/kaytu-io/kaytu/pkg/style"
+	"github.com/kaytu-io/kaytu/pkg/utils"
 	"github.com/kaytu-io/kaytu/view"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"os"
+	"strconv"
 	"time"
 )
 
@@ -67,9 +76,89 @@ func (m *App) View() string {
 	return m.table.View() + "\n"
 }
 
+type listedPlugin struct {
+	Name      string     `json:"name" yaml:"name"`
+	Version   string     `json:"version" yaml:"version"`
+	Provider  string     `json:"provider" yaml:"provider"`
+	Path      string     `json:"path" yaml:"path"`
+	Size      int64      `json:"size" yaml:"size"`
+	ModTime   *time.Time `json:"modTime,omitempty" yaml:"modTime,omitempty"`
+	Pinned    bool       `json:"pinned" yaml:"pinned"`
+	Latest    string     `json:"latest,omitempty" yaml:"latest,omitempty"`
+	Outdated  *bool      `json:"outdated,omitempty" yaml:"outdated,omitempty"`
+	LatestErr string     `json:"latestError,omitempty" yaml:"latestError,omitempty"`
+}
+
+func listPlugins(cmd *cobra.Command) ([]listedPlugin, error) {
+	plugins, err := server.GetPlugins()
+	if err != nil {
+		return nil, err
+	}
+
+	checkUpdates := utils.ReadBooleanFlag(cmd, "check-updates")
+	manager := plugin.New()
+	var result []listedPlugin
+	for _, plg := range plugins {
+		item := listedPlugin{
+			Name:     plg.Config.Name,
+			Version:  plg.Config.Version,
+			Provider: plg.Config.Provider,
+			Path:     plg.Path(),
+			Pinned:   plg.Pinned,
+		}
+		if info, err := os.Stat(item.Path); err == nil {
+			item.Size = info.Size()
+			modTime := info.ModTime()
+			item.ModTime = &modTime
+		}
+		if checkUpdates {
+			item.Latest, err = manager.LatestVersion(cmd.Context(), plg.Config.Name, utils.ReadStringFlag(cmd, "token"))
+			var outdated bool
+			if err == nil {
+				outdated, err = isOutdated(item.Version, item.Latest)
+			}
+			if err != nil {
+				item.LatestErr = err.Error()
+			} else {
+				item.Outdated = &outdated
+			}
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+func (p listedPlugin) row() []string {
+	modTime := ""
+	if p.ModTime != nil {
+		modTime = p.ModTime.Format(time.RFC3339)
+	}
+	outdated := ""
+	if p.Outdated != nil {
+		outdated = strconv.FormatBool(*p.Outdated)
+	}
+	return []string{p.Name, p.Version, p.Provider, p.Path, strconv.FormatInt(p.Size, 10), modTime,
+		strconv.FormatBool(p.Pinned), p.Latest, outdated}
+}
+
+var listHeaders = []string{"Name", "Version", "Provider", "Path", "Size", "Modified", "Pinned", "Latest", "Outdated"}
+
 var listCmd = &cobra.Command{
-	Use: "list",
+	Use:   "list",
+	Short: "List installed plugins",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		output := utils.ReadStringFlag(cmd, "output")
+		switch output {
+		case "interactive":
+		case "table", "json", "yaml", "csv":
+			plugins, err := listPlugins(cmd)
+			if err != nil {
+				return err
+			}
+			return printPlugins(output, plugins)
+		default:
+			return fmt.Errorf("output mode not recognized\npossible values: interactive, table, json, yaml, csv")
+		}
 
 		app, err := NewApp()
 		if err != nil {
@@ -84,3 +173,60 @@ var listCmd = &cobra.Command{
 		return nil
 	},
 }
+
+func printPlugins(output string, plugins []listedPlugin) error {
+	switch output {
+	case "json":
+		out, err := json.Marshal(plugins)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	case "yaml":
+		out, err := yaml.Marshal(plugins)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(out))
+	case "csv":
+		writer := csv.NewWriter(os.Stdout)
+		err := writer.Write(listHeaders)
+		if err != nil {
+			return err
+		}
+		for _, plg := range plugins {
+			err = writer.Write(plg.row())
+			if err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return err
+		}
+	default:
+		t := prettyTable.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.SetStyle(prettyTable.StyleLight)
+		header := prettyTable.Row{}
+		for _, h := range listHeaders {
+			header = append(header, h)
+		}
+		t.AppendHeader(header)
+		for _, plg := range plugins {
+			row := prettyTable.Row{}
+			for _, v := range plg.row() {
+				row = append(row, v)
+			}
+			t.AppendRow(row)
+		}
+		t.Render()
+	}
+
+	for _, plg := range plugins {
+		if plg.LatestErr != "" {
+			os.Stderr.WriteString(fmt.Sprintf("failed to check %s for updates due to %s\n", plg.Name, plg.LatestErr))
+		}
+	}
+	return nil
+}
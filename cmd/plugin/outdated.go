@@ -0,0 +1,101 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/kaytu-io/kaytu/pkg/server"
+	"github.com/kaytu-io/kaytu/pkg/utils"
+	"github.com/spf13/cobra"
+	"os"
+	"strconv"
+)
+
+type outdatedPlugin struct {
+	Name      string `json:"name"`
+	Installed string `json:"installed"`
+	Latest    string `json:"latest"`
+	Outdated  bool   `json:"outdated"`
+	Pinned    bool   `json:"pinned"`
+	Error     string `json:"error,omitempty"`
+}
+
+func isOutdated(installed, latest string) (bool, error) {
+	if latest == "" {
+		return false, nil
+	}
+	cmp, err := plugin.CompareVersions(installed, latest)
+	if err != nil {
+		return false, err
+	}
+	return cmp < 0, nil
+}
+
+var outdatedCmd = &cobra.Command{
+	Use:   "outdated",
+	Short: "Compare installed plugins with their latest releases",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		output := utils.ReadStringFlag(cmd, "output")
+		if output != "table" && output != "json" {
+			return fmt.Errorf("output mode not recognized\npossible values: table, json")
+		}
+
+		plugins, err := server.GetPlugins()
+		if err != nil {
+			return err
+		}
+
+		manager := plugin.New()
+		token := utils.ReadStringFlag(cmd, "token")
+		var result []outdatedPlugin
+		for _, plg := range plugins {
+			item := outdatedPlugin{
+				Name:      plg.Config.Name,
+				Installed: plg.Config.Version,
+				Pinned:    plg.Pinned,
+			}
+			item.Latest, err = manager.LatestVersion(ctx, "github.com/"+plg.Config.Name, token)
+			if err == nil {
+				item.Outdated, err = isOutdated(item.Installed, item.Latest)
+			}
+			if err != nil {
+				item.Error = err.Error()
+			}
+			result = append(result, item)
+		}
+
+		if output == "json" {
+			out, err := json.Marshal(result)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+			return nil
+		}
+
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.SetStyle(table.StyleLight)
+		t.AppendHeader(table.Row{"Name", "Installed", "Latest", "Outdated", "Pinned"})
+		for _, item := range result {
+			latest, outdated := item.Latest, strconv.FormatBool(item.Outdated)
+			if latest == "" {
+				latest = "unknown"
+			}
+			if item.Error != "" {
+				outdated = "unknown"
+			}
+			t.AppendRow(table.Row{item.Name, item.Installed, latest, outdated, strconv.FormatBool(item.Pinned)})
+		}
+		t.Render()
+		for _, item := range result {
+			if item.Error != "" {
+				os.Stderr.WriteString(fmt.Sprintf("failed to check %s for updates due to %s\n", item.Name, item.Error))
+			}
+		}
+		return nil
+	},
+}
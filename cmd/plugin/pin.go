@@ -0,0 +1,47 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+var pinCmd = &cobra.Command{
+	Use:   "pin",
+	Short: "Pin a plugin to its installed version so it is not updated automatically",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return errors.New("please provide plugin name")
+		}
+
+		manager := plugin.New()
+		err := manager.SetPinned(args[0], true)
+		if err != nil {
+			os.Stderr.WriteString(fmt.Sprintf("failed to pin plugin due to %v\n", err))
+			return err
+		}
+		fmt.Println(fmt.Sprintf("Plugin %s pinned", args[0]))
+		return nil
+	},
+}
+
+var unpinCmd = &cobra.Command{
+	Use:   "unpin",
+	Short: "Unpin a plugin so it is updated to the latest release automatically",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return errors.New("please provide plugin name")
+		}
+
+		manager := plugin.New()
+		err := manager.SetPinned(args[0], false)
+		if err != nil {
+			os.Stderr.WriteString(fmt.Sprintf("failed to unpin plugin due to %v\n", err))
+			return err
+		}
+		fmt.Println(fmt.Sprintf("Plugin %s unpinned", args[0]))
+		return nil
+	},
+}
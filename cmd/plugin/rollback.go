@@ -0,0 +1,37 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/kaytu-io/kaytu/pkg/utils"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback <plugin>",
+	Short: "Switch a plugin back to a previously installed version",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		if len(args) == 0 {
+			return errors.New("please provide plugin name")
+		}
+
+		manager := plugin.New()
+		err := manager.StartServer()
+		if err != nil {
+			return err
+		}
+
+		plg, err := manager.Rollback(ctx, args[0], utils.ReadStringFlag(cmd, "to"))
+		if err != nil {
+			os.Stderr.WriteString(fmt.Sprintf("failed to rollback plugin due to %v\n", err))
+			return err
+		}
+		fmt.Println(fmt.Sprintf("Plugin %s rolled back to version %s and pinned, use `kaytu plugin unpin %s` to receive updates again",
+			plg.Config.Name, plg.Config.Version, args[0]))
+		return nil
+	},
+}
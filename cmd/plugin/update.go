@@ -0,0 +1,85 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/kaytu-io/kaytu/pkg/server"
+	"github.com/kaytu-io/kaytu/pkg/utils"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+var updateCmd = &cobra.Command{
+	Use:   "update [plugin]",
+	Short: "Update plugins to their latest release",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		all := utils.ReadBooleanFlag(cmd, "all")
+		if len(args) == 0 && !all {
+			return errors.New("please provide plugin name or use --all")
+		}
+
+		plugins, err := server.GetPlugins()
+		if err != nil {
+			return err
+		}
+
+		var toUpdate []*server.Plugin
+		for _, plg := range plugins {
+			if all || plg.Config.Name == args[0] || "kaytu-io/plugin-"+args[0] == plg.Config.Name {
+				toUpdate = append(toUpdate, plg)
+			}
+		}
+		if len(toUpdate) == 0 {
+			return fmt.Errorf("plugin not found")
+		}
+
+		manager := plugin.New()
+		err = manager.StartServer()
+		if err != nil {
+			return err
+		}
+
+		token := utils.ReadStringFlag(cmd, "token")
+		unsafe := utils.ReadBooleanFlag(cmd, "unsafe")
+		var failed []string
+		for _, plg := range toUpdate {
+			if plg.Pinned {
+				os.Stderr.WriteString(fmt.Sprintf("Skipping plugin %s, it is pinned to version %s\n", plg.Config.Name, plg.Config.Version))
+				continue
+			}
+			err = manager.Install(ctx, "github.com/"+plg.Config.Name, token, unsafe, false)
+			if err != nil {
+				os.Stderr.WriteString(fmt.Sprintf("failed to update plugin %s due to %v\n", plg.Config.Name, err))
+				failed = append(failed, plg.Config.Name)
+			}
+		}
+		if len(failed) > 0 {
+			return fmt.Errorf("failed to update plugins: %v", failed)
+		}
+		return nil
+	},
+}
+
+var autoUpdateCmd = &cobra.Command{
+	Use:       "auto-update <enable|disable>",
+	Short:     "Enable or disable updating plugins automatically before running their commands",
+	ValidArgs: []string{"enable", "disable"},
+	Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := server.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		cfg.DisableAutoUpdate = args[0] == "disable"
+		err = server.SetConfig(*cfg)
+		if err != nil {
+			return err
+		}
+		fmt.Println(fmt.Sprintf("Plugin auto-update %sd", args[0]))
+		return nil
+	},
+}
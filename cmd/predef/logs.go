@@ -0,0 +1,322 @@
+package predef
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/server"
+	"github.com/kaytu-io/kaytu/pkg/utils"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LogsCmd shows what the plugins wrote to stderr, which is where the plugin SDK logs to
+var LogsCmd = &cobra.Command{
+	Use:   "logs [plugin]",
+	Short: "Show the logs of the plugins",
+	Long: "Show the logs of the plugins, or of the given one (e.g. aws or kaytu-io/plugin-aws), " +
+		"including the rotated log files. The logs are kept in " + filepath.Join("~", ".kaytu", "logs"),
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		pluginName := ""
+		if len(args) > 0 {
+			pluginName = args[0]
+		}
+
+		filter := logFilter{errorsOnly: utils.ReadBooleanFlag(c, "errors")}
+		if since := utils.ReadStringFlag(c, "since"); since != "" {
+			d, err := time.ParseDuration(since)
+			if err != nil {
+				return fmt.Errorf("invalid --since %s: %v", since, err)
+			}
+			filter.since = time.Now().Add(-d)
+		}
+		// --since shows everything after the given time unless the number of lines is asked for too
+		lines := int(utils.ReadIntFlag(c, "lines"))
+		if !filter.since.IsZero() && !c.Flags().Changed("lines") {
+			lines = 0
+		}
+
+		sources, err := logSources(pluginName)
+		if err != nil {
+			return err
+		}
+		if len(sources) == 0 && !utils.ReadBooleanFlag(c, "follow") {
+			if pluginName != "" {
+				return fmt.Errorf("no logs found for plugin %s", pluginName)
+			}
+			return fmt.Errorf("no plugin logs found")
+		}
+		prefixed := pluginName == "" || len(sources) > 1
+
+		var entries []logEntry
+		for _, src := range sources {
+			srcEntries, err := src.readAll()
+			if err != nil {
+				return err
+			}
+			for _, e := range srcEntries {
+				if filter.matches(e) {
+					entries = append(entries, e)
+				}
+			}
+		}
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].time.Before(entries[j].time)
+		})
+		if lines > 0 && len(entries) > lines {
+			entries = entries[len(entries)-lines:]
+		}
+		for _, e := range entries {
+			e.print(prefixed)
+		}
+
+		if !utils.ReadBooleanFlag(c, "follow") {
+			return nil
+		}
+		return followLogs(c, pluginName, sources, filter, prefixed)
+	},
+}
+
+var (
+	// the plugin SDK logs with log.LstdFlags in UTC
+	logTimestampLayout = "2006/01/02 15:04:05"
+	logErrorPattern    = regexp.MustCompile(`(?i)\b(error|panic|fatal|failed)\b`)
+)
+
+type logEntry struct {
+	source string
+	// time is zero for lines before the first timestamp of the file
+	time  time.Time
+	lines []string
+	// untimed entries are output without a timestamp, e.g. a panic, they get the time of the entry before
+	untimed bool
+	// continued entries hold lines of an entry read before, when following the file
+	continued bool
+}
+
+func (e logEntry) print(prefixed bool) {
+	for _, line := range e.lines {
+		if prefixed {
+			line = fmt.Sprintf("[%s] %s", e.source, line)
+		}
+		fmt.Println(line)
+	}
+}
+
+type logFilter struct {
+	since      time.Time
+	errorsOnly bool
+}
+
+func (f logFilter) matches(e logEntry) bool {
+	if !f.since.IsZero() && e.time.Before(f.since) {
+		return false
+	}
+	if f.errorsOnly && !logErrorPattern.MatchString(strings.Join(e.lines, "\n")) {
+		return false
+	}
+	return true
+}
+
+type logSource struct {
+	name string
+	path string
+	// offset is how far the file was read, for --follow
+	offset int64
+	// entry is the last entry read, lines without a timestamp belong to it
+	entry *logEntry
+}
+
+// logSources returns the stderr log files of the plugins, or of the given plugin only
+func logSources(pluginName string) ([]*logSource, error) {
+	matches, err := filepath.Glob(filepath.Join(server.LogsDir(), "*.err.logs"))
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []*logSource
+	for _, path := range matches {
+		name := strings.Replace(strings.TrimSuffix(filepath.Base(path), ".err.logs"), "_", "/", 1)
+		if pluginName != "" && !matchesPluginName(name, pluginName) {
+			continue
+		}
+		sources = append(sources, &logSource{name: name, path: path})
+	}
+	return sources, nil
+}
+
+// matchesPluginName accepts the full name of the plugin or its short one, e.g. aws for kaytu-io/plugin-aws
+func matchesPluginName(name, pluginName string) bool {
+	pluginName = strings.TrimPrefix(pluginName, "github.com/")
+	if name == pluginName {
+		return true
+	}
+	_, repo, _ := strings.Cut(name, "/")
+	return repo == pluginName || repo == "plugin-"+pluginName
+}
+
+// readAll reads the rotated files of the source, oldest first, and then the current one
+func (s *logSource) readAll() ([]logEntry, error) {
+	var entries []logEntry
+
+	backups := server.LogBackups(s.path)
+	for i := len(backups) - 1; i >= 0; i-- {
+		content, err := os.ReadFile(backups[i].Path)
+		if err != nil {
+			return nil, err
+		}
+		s.entry = nil
+		entries = append(entries, s.parse(content)...)
+	}
+
+	s.entry = nil
+	content, err := os.ReadFile(s.path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	s.offset = int64(len(content))
+	entries = append(entries, s.parse(content)...)
+	return entries, nil
+}
+
+// parse splits the content into entries, each starting at a timestamped line or at the first line without a
+// timestamp after one. The last entry is kept, since the lines following it might still be written.
+func (s *logSource) parse(content []byte) []logEntry {
+	var entries []logEntry
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(line) >= len(logTimestampLayout) {
+			if t, err := time.ParseInLocation(logTimestampLayout, line[:len(logTimestampLayout)], time.UTC); err == nil {
+				if s.entry != nil {
+					entries = append(entries, *s.entry)
+				}
+				s.entry = &logEntry{source: s.name, time: t, lines: []string{line}}
+				continue
+			}
+		}
+		if s.entry == nil || !s.entry.untimed {
+			if s.entry != nil && len(s.entry.lines) > 0 {
+				entries = append(entries, *s.entry)
+			}
+			next := &logEntry{source: s.name, untimed: true}
+			if s.entry != nil {
+				next.time = s.entry.time
+			}
+			s.entry = next
+		}
+		s.entry.lines = append(s.entry.lines, line)
+	}
+	if s.entry != nil {
+		entries = append(entries, *s.entry)
+	}
+	return entries
+}
+
+// readNew returns the complete lines written since the last read as entries, lines continuing the last entry
+// read before come first in an entry marked as continued
+func (s *logSource) readNew() ([]logEntry, error) {
+	info, err := os.Stat(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the file was rotated
+	if info.Size() < s.offset {
+		s.offset = 0
+		s.entry = nil
+	}
+	if info.Size() == s.offset {
+		return nil, nil
+	}
+
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	_, err = f.Seek(s.offset, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	// a partly written line is read on the next poll
+	end := bytes.LastIndexByte(content, '\n')
+	if end < 0 {
+		return nil, nil
+	}
+	content = content[:end+1]
+	s.offset += int64(len(content))
+
+	if s.entry != nil {
+		s.entry = &logEntry{source: s.name, time: s.entry.time, untimed: s.entry.untimed, continued: true}
+	}
+	var entries []logEntry
+	for _, e := range s.parse(content) {
+		if len(e.lines) > 0 {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+// followLogs prints the lines written to the log files until the CLI is interrupted, log files of plugins
+// started in the meantime are followed too
+func followLogs(c *cobra.Command, pluginName string, sources []*logSource, filter logFilter, prefixed bool) error {
+	known := map[string]bool{}
+	// matched tells whether the last entry of the source was printed, lines continuing it are printed if so
+	matched := map[string]bool{}
+	for _, src := range sources {
+		known[src.path] = true
+		matched[src.path] = src.entry != nil && filter.matches(*src.entry)
+	}
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := logSources(pluginName)
+		if err != nil {
+			return err
+		}
+		for _, src := range current {
+			if !known[src.path] {
+				known[src.path] = true
+				sources = append(sources, src)
+			}
+		}
+
+		for _, src := range sources {
+			entries, err := src.readNew()
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				if !e.continued {
+					matched[src.path] = filter.matches(e)
+				}
+				if matched[src.path] {
+					e.print(prefixed)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/kaytu-io/kaytu/controller"
+	plugin2 "github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/utils"
+	"github.com/kaytu-io/kaytu/preferences"
+	"github.com/spf13/cobra"
+)
+
+var replayCmd = &cobra.Command{
+	Use:   "replay <recording>",
+	Short: "Show the results of a run recorded with --record again",
+	Long: "Show the results of an optimize command recorded with --record again, without starting the plugin or " +
+		"calling any cloud API. The results are shown in the output of the recorded run unless --output is given, " +
+		"at the pace they were recorded at. Changing the preferences in the interactive view doesn't re-evaluate the " +
+		"results, as no plugin is running.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		ctx := c.Context()
+
+		rec, err := plugin2.LoadRecording(args[0])
+		if err != nil {
+			return err
+		}
+		speed, err := c.Flags().GetFloat64("speed")
+		if err != nil {
+			return err
+		}
+		if speed < 0 {
+			return errors.New("--speed can't be negative")
+		}
+
+		output := utils.ReadStringFlag(c, "output")
+		if output == "" {
+			output = rec.Header.Output
+		}
+		switch output {
+		case "interactive":
+		case "table":
+		case "csv":
+		case "json":
+		default:
+			if !rec.Header.RootCommand {
+				return fmt.Errorf("output mode not recognized\npossible values: interactive, table, csv, json")
+			}
+		}
+
+		manager := plugin2.New()
+		var logsController *controller.Logs
+		switch {
+		case rec.Header.RootCommand:
+			manager.SetRootCommandView()
+		case output != "interactive":
+			manager.SetNonInteractiveView(rec.Header.AgentMode)
+			logLevel, err := readLogLevel(c)
+			if err != nil {
+				return err
+			}
+			manager.SetLogLevel(logLevel)
+		default:
+			logsController = controller.NewLogs()
+			manager.SetLogs(logsController)
+		}
+
+		runningPlg, err := manager.Replay(ctx, rec, speed)
+		if err != nil {
+			return err
+		}
+		config := runningPlg.Plugin.Config
+		if manager.NonInteractiveView != nil {
+			if runningPlg.UsesCustomCharts() {
+				manager.NonInteractiveView.SetOptimizations(nil, controller.NewOptimizations[golang.ChartOptimizationItem](),
+					config.OverviewChart, config.DevicesChart)
+			} else {
+				manager.NonInteractiveView.SetOptimizations(controller.NewOptimizations[golang.OptimizationItem](),
+					nil, nil, nil)
+			}
+		}
+		// the preferences page shows the preferences the run was made with
+		preferences.Update(rec.Start().DefaultPreferences)
+
+		// the results are replayed once the recorded command is sent
+		start := func() error {
+			return manager.SendStart(config.Name, rec.Start())
+		}
+		if output == "interactive" && !rec.Header.RootCommand {
+			return runInteractive(c, manager, runningPlg, logsController, start)
+		}
+
+		err = start()
+		if err != nil {
+			return err
+		}
+		if rec.Header.RootCommand {
+			return manager.RootCommandView.WaitAndShowResults()
+		}
+		return manager.NonInteractiveView.WaitAndShowResults(output)
+	},
+}
+
+func init() {
+	replayCmd.Flags().String("output", "", "Show the results in the selected output instead of the recorded one (possible values: interactive, table, csv, json)")
+	replayCmd.Flags().Float64("speed", 1, "Replay the results this many times faster than they were recorded, 0 shows them right away")
+	replayCmd.Flags().String("color-profile", "", "Color profile (true-color, ansi256, ansi, ascii)")
+	replayCmd.Flags().String("log-level", "", "Print the recorded plugin logs with at least this level to stderr in non-interactive output (possible values: debug, info, warn, error)")
+}
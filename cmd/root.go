@@ -10,13 +10,13 @@ import (
 	"github.com/kaytu-io/kaytu/controller"
 	plugin2 "github.com/kaytu-io/kaytu/pkg/plugin"
 	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
+	"github.com/kaytu-io/kaytu/pkg/runner"
 	"github.com/kaytu-io/kaytu/pkg/server"
 	"github.com/kaytu-io/kaytu/pkg/utils"
-	"github.com/kaytu-io/kaytu/pkg/version"
 	"github.com/kaytu-io/kaytu/preferences"
 	"github.com/kaytu-io/kaytu/view"
 	"github.com/muesli/termenv"
-	"github.com/rogpeppe/go-internal/semver"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 	"os"
@@ -59,6 +59,10 @@ func init() {
 	rootCmd.AddCommand(optimizeCmd)
 	rootCmd.AddCommand(preferencesCmd)
 	rootCmd.AddCommand(terraformCmd)
+	rootCmd.AddCommand(predef.LogsCmd)
+	rootCmd.AddCommand(replayCmd)
+
+	optimizeCmd.AddCommand(optimizeAllCmd)
 
 	predef.ApiKeyRootCmd.AddCommand(predef.ApiKeyCreateCmd)
 	predef.ApiKeyRootCmd.AddCommand(predef.ApiKeyListCmd)
@@ -69,6 +73,16 @@ func init() {
 	optimizeCmd.PersistentFlags().String("output", "interactive", "Show optimization results in selected output (possible values: interactive, table, csv, json. default value: interactive)")
 	optimizeCmd.PersistentFlags().Bool("plugin-debug-mode", false, "Enable plugin debug mode (manager wont start plugin)")
 	optimizeCmd.PersistentFlags().Bool("agent-mode", false, "Enable agent mode (to run on kaytu agent)")
+	optimizeCmd.PersistentFlags().Int("plugin-restarts", 0, "Restart the plugin up to the given number of times if it crashes, resending the running command")
+	optimizeCmd.PersistentFlags().Duration("plugin-timeout", plugin2.DefaultRegisterTimeout, "How long to wait for the plugin to start")
+	optimizeCmd.PersistentFlags().Bool("verbose", false, "Print the plugin logs to stderr, same as --log-level debug")
+	optimizeCmd.PersistentFlags().String("log-level", "", "Print the plugin logs with at least this level to stderr in non-interactive output (possible values: debug, info, warn, error)")
+	optimizeCmd.PersistentFlags().String("record", "", "Record the messages exchanged with the plugin to the given file, to be shown again with kaytu replay")
+
+	predef.LogsCmd.Flags().Bool("follow", false, "Keep printing the logs as they're written")
+	predef.LogsCmd.Flags().String("since", "", "Only show the logs written within the given duration, e.g. 1h or 30m")
+	predef.LogsCmd.Flags().Bool("errors", false, "Only show errors, panics and failures")
+	predef.LogsCmd.Flags().Int("lines", 100, "Number of log entries to show, 0 shows all of them")
 
 	terraformCmd.Flags().String("preferences", "", "Path to preferences file (yaml)")
 	terraformCmd.Flags().String("github-owner", "", "Github owner")
@@ -115,6 +129,12 @@ func ExecuteContext(ctx context.Context) {
 		foundMap[p.Config.Name] = true
 	}
 
+	policy, err := plugin2.LoadPolicy()
+	if err != nil {
+		os.Stderr.WriteString(err.Error() + "\n")
+		os.Exit(1)
+	}
+
 	autoInstallList := []string{"aws", "kubernetes"}
 	for _, autoInstall := range autoInstallList {
 		pluginName := autoInstall
@@ -125,7 +145,8 @@ func ExecuteContext(ctx context.Context) {
 		owner, repository, _ := strings.Cut(pluginName, "/")
 		pluginName = owner + "/" + repository
 
-		if _, ok := foundMap[pluginName]; !ok {
+		// default plugins left out of the organization policy are not installed
+		if _, ok := foundMap[pluginName]; !ok && policy.Allows(pluginName) {
 			manager := plugin2.New()
 			err := manager.StartServer()
 			if err != nil {
@@ -155,16 +176,14 @@ func ExecuteContext(ctx context.Context) {
 				Short: cmd.Description,
 				Long:  cmd.Description,
 				RunE: func(c *cobra.Command, args []string) error {
-					ctx := c.Context()
-
-					cfg, err := server.GetConfig()
-					if err != nil {
-						return err
+					// other commands given as arguments are run together with this one
+					if len(args) > 0 {
+						return runOptimizeCommands(c, append([]string{cmd.Name}, args...))
 					}
 
-					nonInteractiveFlag := utils.ReadStringFlag(c, "output")
-					manager := plugin2.New()
+					ctx := c.Context()
 
+					nonInteractiveFlag := utils.ReadStringFlag(c, "output")
 					switch nonInteractiveFlag {
 					case "interactive":
 					case "table":
@@ -174,195 +193,60 @@ func ExecuteContext(ctx context.Context) {
 						return fmt.Errorf("output mode not recognized\npossible values: interactive, table, csv, json. default value: interactive (default \"interactive\")")
 					}
 
-					agentMode := utils.ReadBooleanFlag(c, "agent-mode")
-
-					if nonInteractiveFlag != "interactive" {
-						manager.SetNonInteractiveView(agentMode)
+					opts := runOptions(c, args, plg.Config.Name, cmd, false)
+					opts.Output = nonInteractiveFlag
+					opts.AgentMode = utils.ReadBooleanFlag(c, "agent-mode")
+					opts.MaxRestarts = int(utils.ReadIntFlag(c, "plugin-restarts"))
+					opts.Record = utils.ReadStringFlag(c, "record")
+					if c.Flags().Changed("plugin-timeout") {
+						opts.RegisterTimeout = utils.ReadDurationFlag(c, "plugin-timeout")
 					}
-
-					pluginDebugMode := utils.ReadBooleanFlag(c, "plugin-debug-mode")
-					if pluginDebugMode {
-						manager.SetListenPort(30422)
-					}
-
-					err = manager.StartServer()
+					var err error
+					opts.Preferences, err = readPreferencesFile(c)
 					if err != nil {
 						return err
 					}
 
-					if !pluginDebugMode {
-						repoAddr := "github.com/" + plg.Config.Name
-						if plg.Config.Name == "aws" {
-							repoAddr = "aws"
-						}
-						err = manager.Install(ctx, repoAddr, "", false, false)
-						if err != nil {
-							os.Stderr.WriteString(fmt.Sprintf("plugin auto-update check failed due to %s\n", err))
-						}
-
-						runningPlg := manager.GetPlugin(plg.Config.Name)
-						if runningPlg == nil {
-							err = manager.StartPlugin(ctx, cmd.Name)
-							if err != nil {
-								return err
-							}
-						}
-					}
-
-					waitLoopCount := 100
-					if pluginDebugMode {
-						waitLoopCount = 1000
-					}
-
-					for i := 0; i < waitLoopCount; i++ {
-						runningPlg := manager.GetPlugin(plg.Config.Name)
-						if runningPlg != nil {
-							break
-						}
-						time.Sleep(100 * time.Millisecond)
-					}
-					runningPlg := manager.GetPlugin(plg.Config.Name)
-					if runningPlg == nil {
-						return fmt.Errorf("running plugin not found: %s", plg.Config.Name)
-					}
-					if runningPlg.Plugin.Config.MinKaytuVersion != "" && semver.Compare("v"+version.VERSION, runningPlg.Plugin.Config.MinKaytuVersion) == -1 {
-						return fmt.Errorf("plugin requires kaytu version %s, please update your Kaytu CLI", runningPlg.Plugin.Config.MinKaytuVersion)
-					}
-
+					// the interactive view keeps the plugin logs from the start for its logs page
+					var logsController *controller.Logs
 					if nonInteractiveFlag != "interactive" {
-						if runningPlg.Plugin.Config.DevicesChart != nil && runningPlg.Plugin.Config.OverviewChart != nil {
-							manager.NonInteractiveView.SetOptimizations(nil, controller.NewOptimizations[golang.ChartOptimizationItem](),
-								runningPlg.Plugin.Config.OverviewChart, runningPlg.Plugin.Config.DevicesChart)
-						} else {
-							manager.NonInteractiveView.SetOptimizations(controller.NewOptimizations[golang.OptimizationItem](),
-								nil, nil, nil)
+						opts.LogLevel, err = readLogLevel(c)
+						if err != nil {
+							return err
 						}
+					} else {
+						logsController = controller.NewLogs()
 					}
-
-					flagValues := map[string]string{}
-					flagValues["output"] = nonInteractiveFlag
-					for _, flag := range cmd.GetFlags() {
-						value := utils.ReadStringFlag(c, flag.Name)
-						flagValues[flag.Name] = value
-					}
-
-					for _, rcmd := range runningPlg.Plugin.Config.Commands {
-						if rcmd.Name == cmd.Name {
-							preferences.Update(rcmd.DefaultPreferences)
-
-							if rcmd.LoginRequired && cfg.AccessToken == "" {
-								// login
-								err := predef.LoginCmd().RunE(c, args)
-								if err != nil {
-									return err
-								}
-
-								cfg, err = server.GetConfig()
-								if err != nil {
-									return err
-								}
-							}
-							break
+					opts.Configure = func(manager *plugin2.Manager) {
+						if logsController != nil {
+							manager.SetLogs(logsController)
 						}
+						onShutdown(func() {
+							manager.Shutdown("interrupted", plugin2.ShutdownGracePeriod)
+						})
 					}
 
-					preferencesFlag := utils.ReadStringFlag(c, "preferences")
-					if len(preferencesFlag) > 0 {
-						cnt, err := os.ReadFile(preferencesFlag)
-						if err != nil {
-							return err
-						}
-						var p preferences.PreferencesYamlFile
-						err = yaml.Unmarshal(cnt, &p)
-						if err != nil {
-							return err
-						}
-						err = preferences.UpdateValues(p.Preferences)
-						if err != nil {
-							return err
-						}
+					session, err := runner.Start(ctx, opts)
+					if err != nil {
+						return err
 					}
+					manager, runningPlg := session.Manager, session.Plugin
 
-					err = runningPlg.Stream.Send(&golang.ServerMessage{
-						ServerMessage: &golang.ServerMessage_Start{
-							Start: &golang.StartProcess{
-								Command:            cmd.Name,
-								Flags:              flagValues,
-								KaytuAccessToken:   cfg.AccessToken,
-								DefaultPreferences: preferences.DefaultPreferences(),
-							},
-						},
-					})
+					err = session.Send(ctx)
 					if err != nil {
 						return err
 					}
 
 					if nonInteractiveFlag != "interactive" {
-						err := manager.NonInteractiveView.WaitAndShowResults(nonInteractiveFlag)
-						return err
+						err = manager.NonInteractiveView.WaitAndShowResults(nonInteractiveFlag)
 					} else {
-						helpController := controller.NewHelp()
-
-						jobsController := controller.NewJobs()
-						statusBar := view.NewStatusBarView(jobsController, helpController)
-						jobsPage := view.NewJobsPage(jobsController, helpController, statusBar)
-						contactUsPage := view.NewContactUsPage(helpController)
-
-						var app *view.App
-						if runningPlg.Plugin.Config.DevicesChart != nil && runningPlg.Plugin.Config.OverviewChart != nil {
-							optimizationsController := controller.NewOptimizations[golang.ChartOptimizationItem]()
-							optimizationsPage := view.NewPluginCustomOverviewPageView(runningPlg.Plugin.Config.OverviewChart, optimizationsController, helpController, statusBar)
-							optimizationsDetailsPage := view.NewPluginCustomOptimizationDetailsView(runningPlg.Plugin.Config.DevicesChart, optimizationsController, helpController, statusBar)
-							preferencesPage := view.NewPreferencesConfiguration(helpController, optimizationsController, statusBar)
-							manager.SetCustomUI(jobsController, optimizationsController, &optimizationsPage, &optimizationsDetailsPage)
-							app = view.NewCustomPluginApp(
-								&optimizationsPage,
-								&optimizationsDetailsPage,
-								preferencesPage,
-								jobsPage,
-								contactUsPage,
-							)
-						} else {
-							optimizationsController := controller.NewOptimizations[golang.OptimizationItem]()
-							optimizationsPage := view.NewOptimizationsView(optimizationsController, helpController, statusBar)
-							optimizationsDetailsPage := view.NewOptimizationDetailsView(optimizationsController, helpController, statusBar)
-							preferencesPage := view.NewPreferencesConfiguration(helpController, optimizationsController, statusBar)
-							manager.SetDefaultUI(jobsController, optimizationsController)
-							app = view.NewApp(
-								optimizationsPage,
-								optimizationsDetailsPage,
-								preferencesPage,
-								jobsPage,
-								contactUsPage,
-							)
-						}
-						go checkForLimitsError(app, jobsController)
-
-						if cpf := c.Flag("color-profile"); cpf != nil {
-							out := termenv.DefaultOutput()
-							switch cpf.Value.String() {
-							case "true-color":
-								lipgloss.SetColorProfile(termenv.TrueColor)
-								out = termenv.NewOutput(os.Stdout, termenv.WithProfile(termenv.TrueColor))
-							case "ansi256":
-								lipgloss.SetColorProfile(termenv.ANSI256)
-								out = termenv.NewOutput(os.Stdout, termenv.WithProfile(termenv.ANSI256))
-							case "ansi":
-								lipgloss.SetColorProfile(termenv.ANSI)
-								out = termenv.NewOutput(os.Stdout, termenv.WithProfile(termenv.ANSI))
-							case "ascii":
-								lipgloss.SetColorProfile(termenv.Ascii)
-								out = termenv.NewOutput(os.Stdout, termenv.WithProfile(termenv.Ascii))
-							}
-							lipgloss.DefaultRenderer().SetOutput(out)
-						}
-						p := tea.NewProgram(app, tea.WithFPS(10))
-						if _, err := p.Run(); err != nil {
-							return err
-						}
-
-						return nil
+						err = runInteractive(c, manager, runningPlg, logsController, nil)
+						manager.Shutdown("user quit", plugin2.ShutdownGracePeriod)
 					}
+					if recErr := session.CloseRecording(); recErr != nil {
+						os.Stderr.WriteString(recErr.Error() + "\n")
+					}
+					return err
 				},
 			}
 
@@ -381,59 +265,18 @@ func ExecuteContext(ctx context.Context) {
 				RunE: func(c *cobra.Command, args []string) error {
 					ctx := c.Context()
 
-					manager := plugin2.New()
-					pluginDebugMode := utils.ReadBooleanFlag(c, "plugin-debug-mode")
-					if pluginDebugMode {
-						manager.SetListenPort(30422)
+					opts := runOptions(c, args, plg.Config.Name, cmd, false)
+					opts.Configure = func(manager *plugin2.Manager) {
+						onShutdown(func() {
+							manager.Shutdown("interrupted", plugin2.ShutdownGracePeriod)
+						})
 					}
 
-					err = manager.StartServer()
+					session, err := runner.Start(ctx, opts)
 					if err != nil {
 						return err
 					}
-
-					if !pluginDebugMode {
-						repoAddr := "github.com/" + plg.Config.Name
-						if plg.Config.Name == "aws" {
-							repoAddr = "aws"
-						}
-						err = manager.Install(ctx, repoAddr, "", false, false)
-						if err != nil {
-							fmt.Println("failed due to", err)
-						}
-
-						runningPlg := manager.GetPlugin(plg.Config.Name)
-						if runningPlg == nil {
-							err = manager.StartPlugin(ctx, cmd.Name)
-							if err != nil {
-								return err
-							}
-						}
-					}
-
-					waitLoopCount := 100
-					if pluginDebugMode {
-						waitLoopCount = 1000
-					}
-
-					for i := 0; i < waitLoopCount; i++ {
-						runningPlg := manager.GetPlugin(plg.Config.Name)
-						if runningPlg != nil {
-							break
-						}
-						time.Sleep(100 * time.Millisecond)
-					}
-					runningPlg := manager.GetPlugin(plg.Config.Name)
-					if runningPlg == nil {
-						return fmt.Errorf("running plugin not found: %s", plg.Config.Name)
-					}
-
-					for _, rcmd := range runningPlg.Plugin.Config.Commands {
-						if rcmd.Name == cmd.Name {
-							preferences.Update(rcmd.DefaultPreferences)
-							break
-						}
-					}
+					defer session.Close()
 
 					var items []preferences.PreferenceValueItem
 					for _, p := range preferences.DefaultPreferences() {
@@ -486,103 +329,24 @@ func ExecuteContext(ctx context.Context) {
 					RunE: func(c *cobra.Command, args []string) error {
 						ctx := c.Context()
 
-						cfg, err := server.GetConfig()
-						if err != nil {
-							return err
+						opts := runOptions(c, args, plg.Config.Name, cmd, true)
+						opts.Configure = func(manager *plugin2.Manager) {
+							onShutdown(func() {
+								manager.Shutdown("interrupted", plugin2.ShutdownGracePeriod)
+							})
 						}
 
-						manager := plugin2.New()
-						manager.SetRootCommandView()
-
-						pluginDebugMode := utils.ReadBooleanFlag(c, "plugin-debug-mode")
-						if pluginDebugMode {
-							manager.SetListenPort(30422)
-						}
-
-						err = manager.StartServer()
+						session, err := runner.Start(ctx, opts)
 						if err != nil {
 							return err
 						}
 
-						if !pluginDebugMode {
-							repoAddr := "github.com/" + plg.Config.Name
-							if plg.Config.Name == "aws" {
-								repoAddr = "aws"
-							}
-							err = manager.Install(ctx, repoAddr, "", false, false)
-							if err != nil {
-								os.Stderr.WriteString(fmt.Sprintf("failed due to %s\n", err))
-							}
-
-							runningPlg := manager.GetPlugin(plg.Config.Name)
-							if runningPlg == nil {
-								err = manager.StartPlugin(ctx, cmd.Name)
-								if err != nil {
-									return err
-								}
-							}
-						}
-
-						waitLoopCount := 100
-						if pluginDebugMode {
-							waitLoopCount = 1000
-						}
-
-						for i := 0; i < waitLoopCount; i++ {
-							runningPlg := manager.GetPlugin(plg.Config.Name)
-							if runningPlg != nil {
-								break
-							}
-							time.Sleep(100 * time.Millisecond)
-						}
-						runningPlg := manager.GetPlugin(plg.Config.Name)
-						if runningPlg == nil {
-							return fmt.Errorf("running plugin not found: %s", plg.Config.Name)
-						}
-						if runningPlg.Plugin.Config.MinKaytuVersion != "" && semver.Compare("v"+version.VERSION, runningPlg.Plugin.Config.MinKaytuVersion) == -1 {
-							return fmt.Errorf("plugin requires kaytu version %s, please update your Kaytu CLI", runningPlg.Plugin.Config.MinKaytuVersion)
-						}
-
-						flagValues := map[string]string{}
-						for _, flag := range cmd.GetFlags() {
-							value := utils.ReadStringFlag(c, flag.Name)
-							flagValues[flag.Name] = value
-						}
-
-						for _, rcmd := range runningPlg.Plugin.Config.Commands {
-							if rcmd.Name == cmd.Name {
-								preferences.Update(rcmd.DefaultPreferences)
-
-								if rcmd.LoginRequired && cfg.AccessToken == "" {
-									// login
-									err := predef.LoginCmd().RunE(c, args)
-									if err != nil {
-										return err
-									}
-
-									cfg, err = server.GetConfig()
-									if err != nil {
-										return err
-									}
-								}
-								break
-							}
-						}
-
-						err = runningPlg.Stream.Send(&golang.ServerMessage{
-							ServerMessage: &golang.ServerMessage_Start{
-								Start: &golang.StartProcess{
-									Command:          cmd.Name,
-									Flags:            flagValues,
-									KaytuAccessToken: cfg.AccessToken,
-								},
-							},
-						})
+						err = session.Send(ctx)
 						if err != nil {
 							return err
 						}
 
-						err = manager.RootCommandView.WaitAndShowResults()
+						err = session.Manager.RootCommandView.WaitAndShowResults()
 						if err != nil {
 							return err
 						}
@@ -608,14 +372,147 @@ func ExecuteContext(ctx context.Context) {
 	}
 }
 
-func checkForLimitsError(app *view.App, jobsController *controller.Jobs) {
+// runOptions returns the options running the plugin command as asked by the command line flags
+func runOptions(c *cobra.Command, args []string, pluginName string, cmd *golang.Command, rootCommand bool) runner.RunOptions {
+	// flags left out, e.g. by get-preferences, get their default value
+	flags := map[string]string{}
+	for _, flag := range cmd.GetFlags() {
+		if c.Flags().Lookup(flag.Name) != nil {
+			flags[flag.Name] = utils.ReadStringFlag(c, flag.Name)
+		}
+	}
+
+	return runner.RunOptions{
+		Plugin:          pluginName,
+		Command:         cmd.Name,
+		RootCommand:     rootCommand,
+		Flags:           flags,
+		PluginDebugMode: utils.ReadBooleanFlag(c, "plugin-debug-mode"),
+		Login: func(ctx context.Context) error {
+			return predef.LoginCmd().RunE(c, args)
+		},
+	}
+}
+
+// runInteractive shows the results of the plugin in the interactive view until the user quits. If given, start
+// is called once the view gets the results, e.g. to send the command
+func runInteractive(c *cobra.Command, manager *plugin2.Manager, runningPlg *plugin2.RunningPlugin, logsController *controller.Logs,
+	start func() error) error {
+	helpController := controller.NewHelp()
+
+	jobsController := controller.NewJobs()
+	statusBar := view.NewStatusBarView(jobsController, helpController, logsController)
+	jobsPage := view.NewJobsPage(jobsController, helpController, statusBar)
+	contactUsPage := view.NewContactUsPage(helpController)
+	logsPage := view.NewLogsPage(logsController, helpController, statusBar)
+
+	var app *view.App
+	if runningPlg.UsesCustomCharts() {
+		optimizationsController := controller.NewOptimizations[golang.ChartOptimizationItem]()
+		optimizationsPage := view.NewPluginCustomOverviewPageView(runningPlg.Plugin.Config.OverviewChart, optimizationsController, helpController, statusBar)
+		optimizationsDetailsPage := view.NewPluginCustomOptimizationDetailsView(runningPlg.Plugin.Config.DevicesChart, optimizationsController, helpController, statusBar)
+		preferencesPage := view.NewPreferencesConfiguration(helpController, optimizationsController, statusBar)
+		manager.SetCustomUI(jobsController, optimizationsController, &optimizationsPage, &optimizationsDetailsPage)
+		app = view.NewCustomPluginApp(
+			&optimizationsPage,
+			&optimizationsDetailsPage,
+			preferencesPage,
+			jobsPage,
+			contactUsPage,
+			logsPage,
+		)
+	} else {
+		optimizationsController := controller.NewOptimizations[golang.OptimizationItem]()
+		optimizationsPage := view.NewOptimizationsView(optimizationsController, helpController, statusBar)
+		optimizationsDetailsPage := view.NewOptimizationDetailsView(optimizationsController, helpController, statusBar)
+		preferencesPage := view.NewPreferencesConfiguration(helpController, optimizationsController, statusBar)
+		manager.SetDefaultUI(jobsController, optimizationsController)
+		app = view.NewApp(
+			optimizationsPage,
+			optimizationsDetailsPage,
+			preferencesPage,
+			jobsPage,
+			contactUsPage,
+			logsPage,
+		)
+	}
+	go checkForLimitsError(app, jobsController)
+	if start != nil {
+		err := start()
+		if err != nil {
+			return err
+		}
+	}
+
+	setColorProfile(c)
+	p := tea.NewProgram(app, tea.WithFPS(10))
+	_, err := p.Run()
+	return err
+}
+
+// readPreferencesFile reads the preferences file given by --preferences, if any
+func readPreferencesFile(c *cobra.Command) ([]preferences.PreferenceValueItem, error) {
+	preferencesFlag := utils.ReadStringFlag(c, "preferences")
+	if len(preferencesFlag) == 0 {
+		return nil, nil
+	}
+	cnt, err := os.ReadFile(preferencesFlag)
+	if err != nil {
+		return nil, err
+	}
+	var p preferences.PreferencesYamlFile
+	err = yaml.Unmarshal(cnt, &p)
+	if err != nil {
+		return nil, err
+	}
+	return p.Preferences, nil
+}
+
+// readLogLevel returns the level of the plugin logs printed to stderr as asked by --log-level or --verbose, the
+// interactive view shows them on its logs page instead
+func readLogLevel(c *cobra.Command) (golang.LogLevel, error) {
+	name := utils.ReadStringFlag(c, "log-level")
+	if name == "" && utils.ReadBooleanFlag(c, "verbose") {
+		name = "debug"
+	}
+	if name == "" {
+		return golang.LogLevel_LOG_LEVEL_UNSPECIFIED, nil
+	}
+	return sdk.ParseLogLevel(name)
+}
+
+func setColorProfile(c *cobra.Command) {
+	if cpf := c.Flag("color-profile"); cpf != nil {
+		out := termenv.DefaultOutput()
+		switch cpf.Value.String() {
+		case "true-color":
+			lipgloss.SetColorProfile(termenv.TrueColor)
+			out = termenv.NewOutput(os.Stdout, termenv.WithProfile(termenv.TrueColor))
+		case "ansi256":
+			lipgloss.SetColorProfile(termenv.ANSI256)
+			out = termenv.NewOutput(os.Stdout, termenv.WithProfile(termenv.ANSI256))
+		case "ansi":
+			lipgloss.SetColorProfile(termenv.ANSI)
+			out = termenv.NewOutput(os.Stdout, termenv.WithProfile(termenv.ANSI))
+		case "ascii":
+			lipgloss.SetColorProfile(termenv.Ascii)
+			out = termenv.NewOutput(os.Stdout, termenv.WithProfile(termenv.Ascii))
+		}
+		lipgloss.DefaultRenderer().SetOutput(out)
+	}
+}
+
+// pageChanger is the interactive view, an App or a SourcesApp
+type pageChanger interface {
+	tea.Model
+	ChangePage(id view.PageEnum) tea.Cmd
+}
+
+func checkForLimitsError(app pageChanger, jobsController *controller.Jobs) {
 	for {
-		runningJobs := jobsController.FailedJobs()
-		for _, v := range runningJobs {
-			if utils.MatchesLimitPattern(v) {
-				_ = app.ChangePage(view.Page_ContactUs)
-				return
-			}
+		if jobsController.HasFailure(golang.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED) {
+			_ = app.ChangePage(view.Page_ContactUs)
+			return
 		}
 		time.Sleep(500 * time.Millisecond)
 	}
@@ -4,17 +4,51 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"sync"
+	"syscall"
 )
 
+var (
+	shutdownHooks     []func()
+	shutdownHooksLock sync.Mutex
+)
+
+// onShutdown registers a function that's run when the CLI is interrupted, before the context is cancelled
+func onShutdown(f func()) {
+	shutdownHooksLock.Lock()
+	defer shutdownHooksLock.Unlock()
+	shutdownHooks = append(shutdownHooks, f)
+}
+
+func runShutdownHooks() {
+	shutdownHooksLock.Lock()
+	hooks := append([]func(){}, shutdownHooks...)
+	shutdownHooksLock.Unlock()
+
+	for _, f := range hooks {
+		f()
+	}
+}
+
 func AppendSignalHandling(ctx context.Context) context.Context {
 	ctx, cancel := context.WithCancel(ctx)
 
 	cancelChan := make(chan os.Signal, 100)
 
-	signal.Notify(cancelChan, os.Interrupt, os.Kill)
+	signal.Notify(cancelChan, os.Interrupt, os.Kill, syscall.SIGTERM)
 
 	go func() {
 		<-cancelChan
+		// plugins are given a grace period to stop, a second signal exits right away
+		done := make(chan struct{})
+		go func() {
+			runShutdownHooks()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-cancelChan:
+		}
 		cancel()
 		os.Exit(1)
 	}()
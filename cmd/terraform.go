@@ -18,7 +18,6 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
 )
 
 var terraformCmd = &cobra.Command{
@@ -47,20 +46,16 @@ var terraformCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		onShutdown(func() {
+			manager.Shutdown("interrupted", plugin2.ShutdownGracePeriod)
+		})
 		err = manager.StartPlugin(ctx, "rds-instance")
 		if err != nil {
 			return err
 		}
-		for i := 0; i < 100; i++ {
-			runningPlg := manager.GetPlugin("kaytu-io/plugin-aws")
-			if runningPlg != nil {
-				break
-			}
-			time.Sleep(100 * time.Millisecond)
-		}
-		runningPlg := manager.GetPlugin("kaytu-io/plugin-aws")
-		if runningPlg == nil {
-			return fmt.Errorf("running plugin not found")
+		runningPlg, err := manager.WaitForPlugin(ctx, "kaytu-io/plugin-aws")
+		if err != nil {
+			return err
 		}
 		cfg, err := server.GetConfig()
 		if err != nil {
@@ -78,15 +73,11 @@ var terraformCmd = &cobra.Command{
 				break
 			}
 		}
-		err = runningPlg.Stream.Send(&golang.ServerMessage{
-			ServerMessage: &golang.ServerMessage_Start{
-				Start: &golang.StartProcess{
-					Command:            "rds-instance",
-					Flags:              nil,
-					KaytuAccessToken:   cfg.AccessToken,
-					DefaultPreferences: preferences.DefaultPreferences(),
-				},
-			},
+		err = manager.SendStart("kaytu-io/plugin-aws", &golang.StartProcess{
+			Command:            "rds-instance",
+			Flags:              nil,
+			KaytuAccessToken:   cfg.AccessToken,
+			DefaultPreferences: preferences.DefaultPreferences(),
 		})
 		if err != nil {
 			return err
@@ -3,6 +3,7 @@ package controller
 import (
 	"fmt"
 	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
 	"sort"
 	"sync"
 	"time"
@@ -11,6 +12,9 @@ import (
 type Jobs struct {
 	runningJobsMap sync.Map
 	failedJobsMap  sync.Map
+	// failedCodes holds the error codes jobs and plugin errors failed with
+	failedCodes sync.Map
+	progress    *JobsProgress
 
 	statusErr string
 
@@ -25,6 +29,7 @@ func NewJobs() *Jobs {
 		statusErr:      "",
 		jobChan:        make(chan *golang.JobResult, 10000),
 		errorChan:      make(chan error, 10000),
+		progress:       NewJobsProgress(),
 	}
 	go jobs.UpdateStatus()
 
@@ -41,6 +46,35 @@ func (m *Jobs) RunningJobs() []string {
 	return res
 }
 
+type RunningJob struct {
+	ID          string
+	Description string
+	// Progress is nil if the job didn't report any
+	Progress *JobProgress
+}
+
+// RunningJobsWithProgress returns the running jobs sorted by description, with the progress they reported
+func (m *Jobs) RunningJobsWithProgress() []RunningJob {
+	var res []RunningJob
+	m.runningJobsMap.Range(func(key, value any) bool {
+		res = append(res, RunningJob{
+			ID:          key.(string),
+			Description: value.(string),
+			Progress:    m.progress.Get(key.(string)),
+		})
+		return true
+	})
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Description < res[j].Description
+	})
+	return res
+}
+
+// ETA is the estimated time until the running jobs finish, zero if it can't be estimated
+func (m *Jobs) ETA() time.Duration {
+	return m.progress.ETA()
+}
+
 func (m *Jobs) FailedJobs() []string {
 	var res []string
 	m.failedJobsMap.Range(func(key, value any) bool {
@@ -51,10 +85,21 @@ func (m *Jobs) FailedJobs() []string {
 	return res
 }
 
+// HasFailure reports whether a job or the plugin failed with the given error code
+func (m *Jobs) HasFailure(code golang.ErrorCode) bool {
+	_, ok := m.failedCodes.Load(code)
+	return ok
+}
+
 func (m *Jobs) UpdateStatus() {
 	for {
 		select {
 		case job := <-m.jobChan:
+			m.progress.Update(job)
+			// progress updates are frequent and don't change the running jobs
+			if job.GetProgress() != nil && !job.Done {
+				continue
+			}
 			if !job.Done {
 				m.runningJobsMap.Store(job.Id, job.Description)
 			} else {
@@ -62,8 +107,14 @@ func (m *Jobs) UpdateStatus() {
 			}
 			if len(job.FailureMessage) > 0 {
 				m.failedJobsMap.Store(job.Id, fmt.Sprintf("%s failed due to %s", job.Description, job.FailureMessage))
+				if code := job.GetError().GetCode(); code != golang.ErrorCode_ERROR_CODE_UNSPECIFIED {
+					m.failedCodes.Store(code, true)
+				}
 			}
 		case err := <-m.errorChan:
+			if code := sdk.ErrorCode(err); code != golang.ErrorCode_ERROR_CODE_UNSPECIFIED {
+				m.failedCodes.Store(code, true)
+			}
 			m.statusErr = fmt.Sprintf("%s\nFailed due to %v", m.statusErr, err)
 		}
 		time.Sleep(100 * time.Millisecond)
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"sync"
+	"time"
+)
+
+// maxLogRecords is the number of plugin log records kept, older ones are dropped
+const maxLogRecords = 2000
+
+type LogRecord struct {
+	Source  string
+	Level   golang.LogLevel
+	Time    time.Time
+	Message string
+	Fields  map[string]string
+}
+
+// Logs keeps the latest log records sent by the plugins, shown in the logs page
+type Logs struct {
+	lock    sync.Mutex
+	records []LogRecord
+}
+
+func NewLogs() *Logs {
+	return &Logs{}
+}
+
+func (l *Logs) Add(source string, entry *golang.LogEntry) {
+	record := LogRecord{
+		Source:  source,
+		Level:   entry.GetLevel(),
+		Time:    time.Now(),
+		Message: entry.GetMessage(),
+		Fields:  entry.GetFields(),
+	}
+	if entry.GetTimestamp() != nil {
+		record.Time = entry.GetTimestamp().AsTime()
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.records = append(l.records, record)
+	if len(l.records) > maxLogRecords {
+		l.records = append([]LogRecord(nil), l.records[len(l.records)-maxLogRecords:]...)
+	}
+}
+
+// Records returns the records with at least the given level, oldest first
+func (l *Logs) Records(minLevel golang.LogLevel) []LogRecord {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	var res []LogRecord
+	for _, r := range l.records {
+		if r.Level >= minLevel {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// Count returns the number of records with at least the given level
+func (l *Logs) Count(minLevel golang.LogLevel) int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	count := 0
+	for _, r := range l.records {
+		if r.Level >= minLevel {
+			count++
+		}
+	}
+	return count
+}
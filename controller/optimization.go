@@ -2,11 +2,18 @@ package controller
 
 import (
 	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"sync"
 	"sync/atomic"
 )
 
+// sourcedItem is an item queued with the source it came from, empty unless several sources are shown together
+type sourcedItem[T golang.OptimizationItem | golang.ChartOptimizationItem] struct {
+	source string
+	item   *T
+}
+
 type Optimizations[T golang.OptimizationItem | golang.ChartOptimizationItem] struct {
-	itemsChan          chan *T
+	itemsChan          chan sourcedItem[T]
 	inProcessItemCount atomic.Int32
 	items              []*T
 
@@ -18,13 +25,21 @@ type Optimizations[T golang.OptimizationItem | golang.ChartOptimizationItem] str
 
 	selectedItem *T
 
-	reEvaluateFunc func(id string, items []*golang.PreferenceItem)
+	// sources maps the items to the command they came from when several are shown together, items are told apart
+	// by their source and id as different sources can use the same ids
+	sources map[*T]string
+
+	reEvaluateFunc func(source, id string, items []*golang.PreferenceItem)
 	initializing   bool
+
+	// lock guards the items, their sources, the summaries and initializing, they're set by the process goroutines
+	// and the manager while the views read them
+	lock sync.Mutex
 }
 
 func NewOptimizations[T golang.OptimizationItem | golang.ChartOptimizationItem]() *Optimizations[T] {
 	o := Optimizations[T]{
-		itemsChan:             make(chan *T, 1000),
+		itemsChan:             make(chan sourcedItem[T], 1000),
 		inProcessItemCount:    atomic.Int32{},
 		initializing:          true,
 		summaryChan:           make(chan string),
@@ -44,33 +59,29 @@ func (o *Optimizations[T]) Process() {
 		}
 	}()
 
-	for newItem := range o.itemsChan {
-		o.inProcessItemCount.Add(1)
-		if o.initializing {
-			o.initializing = false
-		}
+	for queued := range o.itemsChan {
+		newItem := queued.item
 		updated := false
+		o.lock.Lock()
+		o.initializing = false
 		for idx, i := range o.items {
-			switch castedNewItem := any(newItem).(type) {
-			case *golang.OptimizationItem:
-				castedI := any(i).(*golang.OptimizationItem)
-				if castedNewItem.Id == castedI.Id {
-					o.items[idx] = newItem
-					updated = true
-					break
-				}
-			case *golang.ChartOptimizationItem:
-				castedI := any(i).(*golang.ChartOptimizationItem)
-				if castedNewItem.GetOverviewChartRow().GetRowId() == castedI.GetOverviewChartRow().GetRowId() {
-					o.items[idx] = newItem
-					updated = true
-					break
-				}
+			if itemId(i) == itemId(newItem) && o.sources[i] == queued.source {
+				delete(o.sources, i)
+				o.items[idx] = newItem
+				updated = true
+				break
 			}
 		}
 		if !updated {
 			o.items = append(o.items, newItem)
 		}
+		if queued.source != "" {
+			if o.sources == nil {
+				o.sources = map[*T]string{}
+			}
+			o.sources[newItem] = queued.source
+		}
+		o.lock.Unlock()
 		o.inProcessItemCount.Add(-1)
 	}
 }
@@ -87,26 +98,66 @@ func (o *Optimizations[T]) SummaryProcess() {
 		select {
 		case msg := <-o.summaryChan:
 			o.inProcessSummaryCount.Add(1)
+			o.lock.Lock()
 			o.summary = msg
+			o.lock.Unlock()
 			o.inProcessSummaryCount.Add(-1)
 
 		case msg := <-o.summaryTableChan:
 			o.inProcessSummaryCount.Add(1)
+			o.lock.Lock()
 			o.summaryTable = msg
+			o.lock.Unlock()
 			o.inProcessSummaryCount.Add(-1)
 		}
 	}
 }
 
+// SendItem queues the item, it's counted as in process from now on so IsProcessing doesn't miss it while it's
+// taken from the queue. An item already shown, e.g. sent again while it's re-evaluated, keeps its source.
 func (o *Optimizations[T]) SendItem(item *T) {
-	o.itemsChan <- item
+	o.SendItemFrom(o.ItemSource(item), item)
 }
 
+// SendItemFrom adds the item as a result of the given source, it replaces the item of the same source and id
+func (o *Optimizations[T]) SendItemFrom(source string, item *T) {
+	o.inProcessItemCount.Add(1)
+	o.itemsChan <- sourcedItem[T]{source: source, item: item}
+}
+
+// ItemSource returns the source of the item, or an empty string if it has none
+func (o *Optimizations[T]) ItemSource(item *T) string {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return o.sources[item]
+}
+
+// HasSources reports whether the items come from several sources
+func (o *Optimizations[T]) HasSources() bool {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return len(o.sources) > 0
+}
+
+func itemId[T golang.OptimizationItem | golang.ChartOptimizationItem](item *T) string {
+	switch casted := any(item).(type) {
+	case *golang.OptimizationItem:
+		return casted.GetId()
+	case *golang.ChartOptimizationItem:
+		return casted.GetOverviewChartRow().GetRowId()
+	}
+	return ""
+}
+
+// Items returns the items received so far, the slice is a copy so it can be read while more items come
 func (o *Optimizations[T]) Items() []*T {
-	return o.items
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return append([]*T(nil), o.items...)
 }
 
-func (o *Optimizations[T]) SetReEvaluateFunc(f func(id string, items []*golang.PreferenceItem)) {
+// SetReEvaluateFunc sets the function asking the source of an item to re-evaluate it
+func (o *Optimizations[T]) SetReEvaluateFunc(f func(source, id string, items []*golang.PreferenceItem)) {
 	o.reEvaluateFunc = f
 }
 
@@ -118,15 +169,20 @@ func (o *Optimizations[T]) SelectedItem() *T {
 	return o.selectedItem
 }
 
-func (o *Optimizations[T]) ReEvaluate(id string, preferences []*golang.PreferenceItem) {
-	o.reEvaluateFunc(id, preferences)
+// ReEvaluate asks the source of the item to evaluate it again with the given preferences
+func (o *Optimizations[T]) ReEvaluate(item *T, preferences []*golang.PreferenceItem) {
+	o.reEvaluateFunc(o.ItemSource(item), itemId(item), preferences)
 }
 
 func (o *Optimizations[T]) GetInitialization() bool {
+	o.lock.Lock()
+	defer o.lock.Unlock()
 	return o.initializing
 }
 
 func (o *Optimizations[T]) SetInitialization(b bool) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
 	o.initializing = b
 }
 
@@ -139,10 +195,14 @@ func (o *Optimizations[T]) SetResultSummaryTable(msg *golang.ResultSummaryTable)
 }
 
 func (o *Optimizations[T]) GetResultSummary() string {
+	o.lock.Lock()
+	defer o.lock.Unlock()
 	return o.summary
 }
 
 func (o *Optimizations[T]) GetResultSummaryTable() *golang.ResultSummaryTable {
+	o.lock.Lock()
+	defer o.lock.Unlock()
 	return o.summaryTable
 }
 
@@ -164,9 +224,5 @@ func (o *Optimizations[T]) IsProcessing() bool {
 		return true
 	}
 
-	if o.initializing {
-		return true
-	}
-
-	return false
+	return o.GetInitialization()
 }
@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestOptimizationsSourcesWithSameId(t *testing.T) {
+	o := NewOptimizations[golang.OptimizationItem]()
+	type reEvaluation struct{ source, id string }
+	var reEvaluations []reEvaluation
+	o.SetReEvaluateFunc(func(source, id string, items []*golang.PreferenceItem) {
+		reEvaluations = append(reEvaluations, reEvaluation{source, id})
+	})
+
+	o.SendItemFrom("ec2-instance", &golang.OptimizationItem{Id: "default", Name: "ec2"})
+	o.SendItemFrom("kubernetes-pods", &golang.OptimizationItem{Id: "default", Name: "pods"})
+	o.SendItemFrom("ec2-instance", &golang.OptimizationItem{Id: "default", Name: "ec2 updated"})
+	o.SetInitialization(false)
+	assert.Eventually(t, func() bool { return !o.IsProcessing() }, time.Second, time.Millisecond)
+
+	items := o.Items()
+	if assert.Len(t, items, 2) {
+		assert.Equal(t, "ec2 updated", items[0].Name)
+		assert.Equal(t, "ec2-instance", o.ItemSource(items[0]))
+		assert.Equal(t, "pods", items[1].Name)
+		assert.Equal(t, "kubernetes-pods", o.ItemSource(items[1]))
+	}
+
+	// an item sent again by the views keeps its source
+	items[1].Loading = true
+	o.SendItem(items[1])
+	o.ReEvaluate(items[1], nil)
+	assert.Eventually(t, func() bool { return !o.IsProcessing() }, time.Second, time.Millisecond)
+	assert.Len(t, o.Items(), 2)
+	assert.Equal(t, "kubernetes-pods", o.ItemSource(o.Items()[1]))
+	assert.Equal(t, []reEvaluation{{"kubernetes-pods", "default"}}, reEvaluations)
+}
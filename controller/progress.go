@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"sync"
+	"time"
+)
+
+// JobProgress is the last progress reported by a running job
+type JobProgress struct {
+	Current uint64
+	Total   uint64
+	Phase   string
+	// ETA is the estimated time left, zero if it can't be estimated yet
+	ETA time.Duration
+}
+
+// Fraction is the completed part of the job between 0 and 1, or -1 if the total is not known
+func (p JobProgress) Fraction() float64 {
+	if p.Total == 0 {
+		return -1
+	}
+	if p.Current >= p.Total {
+		return 1
+	}
+	return float64(p.Current) / float64(p.Total)
+}
+
+// JobsProgress keeps track of the progress reported by running jobs to estimate when they finish
+type JobsProgress struct {
+	lock sync.Mutex
+	jobs map[string]*trackedJob
+}
+
+type trackedJob struct {
+	// started is when the job first reported its progress with a known total, jobs are announced when they're
+	// queued so the time before that isn't part of the estimate. startFraction is the progress reported then.
+	started       time.Time
+	startFraction float64
+	updated       time.Time
+	progress      *JobProgress
+}
+
+// eta extrapolates the time the progress since the first report took to the remaining part, less the time
+// since it was reported
+func (t *trackedJob) eta() time.Duration {
+	if t.progress == nil || t.started.IsZero() {
+		return 0
+	}
+	fraction := t.progress.Fraction()
+	if fraction <= t.startFraction {
+		return 0
+	}
+	elapsed := t.updated.Sub(t.started)
+	eta := time.Duration(float64(elapsed)*(1-fraction)/(fraction-t.startFraction)) - time.Since(t.updated)
+	if eta < 0 {
+		return 0
+	}
+	return eta
+}
+
+func NewJobsProgress() *JobsProgress {
+	return &JobsProgress{
+		jobs: map[string]*trackedJob{},
+	}
+}
+
+// Update tracks the job from its first update until it's done. Progress of jobs that aren't running is ignored,
+// as it may arrive after the job finished.
+func (p *JobsProgress) Update(job *golang.JobResult) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if job.Done {
+		delete(p.jobs, job.Id)
+		return
+	}
+
+	tracked, ok := p.jobs[job.Id]
+	if job.GetProgress() == nil {
+		if !ok {
+			p.jobs[job.Id] = &trackedJob{}
+		}
+		return
+	}
+	if !ok {
+		return
+	}
+
+	tracked.updated = time.Now()
+	tracked.progress = &JobProgress{
+		Current: job.GetProgress().GetCurrent(),
+		Total:   job.GetProgress().GetTotal(),
+		Phase:   job.GetProgress().GetPhase(),
+	}
+	if tracked.started.IsZero() && tracked.progress.Fraction() >= 0 {
+		tracked.started = tracked.updated
+		tracked.startFraction = tracked.progress.Fraction()
+	}
+}
+
+// Get returns the progress of the running job, nil if it didn't report any
+func (p *JobsProgress) Get(id string) *JobProgress {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	tracked, ok := p.jobs[id]
+	if !ok || tracked.progress == nil {
+		return nil
+	}
+	progress := *tracked.progress
+	progress.ETA = tracked.eta()
+	return &progress
+}
+
+// ETA is the time left until the last of the running jobs finishes, jobs run concurrently so it's the longest
+// estimate of the jobs reporting progress. It's zero when no job reported enough progress to estimate it.
+func (p *JobsProgress) ETA() time.Duration {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var eta time.Duration
+	for _, tracked := range p.jobs {
+		if jobEta := tracked.eta(); jobEta > eta {
+			eta = jobEta
+		}
+	}
+	return eta
+}
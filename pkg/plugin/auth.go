@@ -0,0 +1,33 @@
+package plugin
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newAuthToken() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// authenticate rejects streams that don't carry the token given to the plugins started by this manager
+func (m *Manager) authenticate(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if m.token != "" {
+		md, _ := metadata.FromIncomingContext(ss.Context())
+		values := md.Get(sdk.AuthTokenMetadataKey)
+		if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(m.token)) != 1 {
+			return status.Error(codes.Unauthenticated, "invalid plugin token")
+		}
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,201 @@
+package plugin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// devProcessName is the name the plugin run by a DevSession is tracked under until it registers with its own
+const devProcessName = "dev"
+
+// errDevPluginStopped is reported to the view waiting for the results of a dev plugin stopped for a rebuild
+var errDevPluginStopped = errors.New("plugin stopped to be rebuilt")
+
+// DevSession runs a plugin from its source for `kaytu plugin dev`: it's built with go build, started against
+// the manager and rebuilt and restarted when the source changes. The plugin writes to the CLI's stderr.
+type DevSession struct {
+	manager *Manager
+	dir     string
+	binary  string
+	// name is the name the plugin registered with, empty until it first registers
+	name string
+
+	proc     *pluginProcess
+	stopping atomic.Bool
+	sources  map[string]time.Time
+}
+
+// NewDevSession returns a session building the plugin in dir. The binary is built in a temporary directory
+// removed by Close, it's the same for the same dir so it's reused if the CLI is interrupted.
+func (m *Manager) NewDevSession(dir string) (*DevSession, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(dir, "go.mod")); err != nil {
+		return nil, fmt.Errorf("%s is not the root of a go module: %v", dir, err)
+	}
+
+	sum := sha256.Sum256([]byte(dir))
+	tmpDir := filepath.Join(os.TempDir(), "kaytu-plugin-dev-"+hex.EncodeToString(sum[:6]))
+	err = os.MkdirAll(tmpDir, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	binary := filepath.Join(tmpDir, "plugin")
+	if runtime.GOOS == "windows" {
+		binary += ".exe"
+	}
+
+	d := &DevSession{manager: m, dir: dir, binary: binary}
+	d.sources, err = d.snapshot()
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		return nil, err
+	}
+	return d, nil
+}
+
+// Name is the name the plugin registered with
+func (d *DevSession) Name() string {
+	return d.name
+}
+
+// Build runs go build on the plugin source, the error holds the compiler output
+func (d *DevSession) Build(ctx context.Context) error {
+	cmd := exec.CommandContext(ctx, "go", "build", "-o", d.binary, ".")
+	cmd.Dir = d.dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("go build failed: %v\n%s", err, strings.TrimRight(string(out), "\n"))
+	}
+	return nil
+}
+
+// Start starts the built plugin, stopping the one running before, and waits for it to register
+func (d *DevSession) Start(ctx context.Context) (*RunningPlugin, error) {
+	d.Stop()
+
+	m := d.manager
+	cmd := pluginCommand(ctx, d.binary, m.ServerAddr(), m.pluginEnv())
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	err := cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+	d.stopping.Store(false)
+	d.proc = m.trackProcess(devProcessName, cmd)
+	go d.wait(d.proc)
+
+	timeout := m.registerTimeout
+	if timeout <= 0 {
+		timeout = DefaultRegisterTimeout
+	}
+	// only the dev plugin runs against the manager, it's accepted whatever its name
+	running, err := m.waitForRegistration(ctx, devProcessName, timeout, func(RunningPlugin) bool {
+		return true
+	})
+	if err != nil {
+		d.Stop()
+		return nil, err
+	}
+	d.name = running.Plugin.Config.Name
+	return running, nil
+}
+
+// Replay sends the last StartProcess sent to the plugin again, it reports whether there was one. It's used once
+// the restarted plugin is ready for its results.
+func (d *DevSession) Replay() (bool, error) {
+	m := d.manager
+	m.pluginsLock.Lock()
+	start := m.lastStart[d.name]
+	m.pluginsLock.Unlock()
+	if start == nil {
+		return false, nil
+	}
+	return true, m.SendStart(d.name, start)
+}
+
+// wait reports the plugin exiting while its results are waited for
+func (d *DevSession) wait(proc *pluginProcess) {
+	_ = proc.cmd.Wait()
+	// reported before done is closed, so Stop returns once the view waiting for the results was told
+	d.manager.removeRunning(d.name)
+	if d.stopping.Load() {
+		d.manager.publishPluginExit(errDevPluginStopped)
+	} else {
+		d.manager.publishPluginExit(&PluginExitError{Name: d.name, ExitCode: proc.cmd.ProcessState.ExitCode()})
+	}
+	close(proc.done)
+}
+
+// Stop kills the running plugin, there's nothing to keep from a plugin about to be rebuilt
+func (d *DevSession) Stop() {
+	if d.proc == nil {
+		return
+	}
+	d.stopping.Store(true)
+	_ = d.proc.cmd.Process.Kill()
+	<-d.proc.done
+	d.proc = nil
+}
+
+// Close stops the plugin and removes the built binary
+func (d *DevSession) Close() {
+	d.Stop()
+	os.RemoveAll(filepath.Dir(d.binary))
+}
+
+// Changed reports whether a go source file, go.mod or go.sum of the plugin changed since the last call
+func (d *DevSession) Changed() (bool, error) {
+	sources, err := d.snapshot()
+	if err != nil {
+		return false, err
+	}
+	changed := len(sources) != len(d.sources)
+	for path, modTime := range sources {
+		if prev, ok := d.sources[path]; !ok || !prev.Equal(modTime) {
+			changed = true
+		}
+	}
+	d.sources = sources
+	return changed, nil
+}
+
+// snapshot returns the modification times of the files the plugin is built from
+func (d *DevSession) snapshot() (map[string]time.Time, error) {
+	sources := map[string]time.Time{}
+	err := filepath.WalkDir(d.dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := entry.Name()
+		if entry.IsDir() {
+			if path != d.dir && (strings.HasPrefix(name, ".") || name == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(name, ".go") && name != "go.mod" && name != "go.sum" {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		sources[path] = info.ModTime()
+		return nil
+	})
+	return sources, err
+}
@@ -0,0 +1,21 @@
+package plugin
+
+import (
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/utils"
+)
+
+// withErrorCode fills the structured error of jobs failed in plugins that predate structured errors, their
+// limit errors can only be recognized by the message
+func withErrorCode(job *golang.JobResult) *golang.JobResult {
+	if job.GetFailureMessage() == "" || job.GetError() != nil {
+		return job
+	}
+
+	job.Error = &golang.Error{Error: job.GetFailureMessage()}
+	if utils.MatchesLimitPattern(fmt.Sprintf("%s failed due to %s", job.GetDescription(), job.GetFailureMessage())) {
+		job.Error.Code = golang.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED
+	}
+	return job
+}
@@ -0,0 +1,209 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"time"
+)
+
+const (
+	// DefaultRegisterTimeout is how long WaitForPlugin waits for a started plugin to register
+	DefaultRegisterTimeout = 10 * time.Second
+	// DebugModeRegisterTimeout leaves time to start the plugin by hand in plugin debug mode
+	DebugModeRegisterTimeout = 100 * time.Second
+	// DefaultPingTimeout is how long a registered plugin is given to answer a ping
+	DefaultPingTimeout = 5 * time.Second
+
+	// installRegisterTimeout is used while installing, when the plugin is started for the first time
+	installRegisterTimeout = 30 * time.Second
+	// restartRegisterTimeout is used for a plugin restarted after a crash
+	restartRegisterTimeout = 30 * time.Second
+)
+
+// PluginRegisterError is returned when a plugin doesn't register with the manager within the timeout
+type PluginRegisterError struct {
+	Name    string
+	Timeout time.Duration
+	// Stderr is the tail of the plugin's err logs written since it was started
+	Stderr string
+}
+
+func (e *PluginRegisterError) Error() string {
+	msg := fmt.Sprintf("plugin %s did not register within %s", e.Name, e.Timeout)
+	if e.Stderr != "" {
+		msg += ", last output:\n" + e.Stderr
+	}
+	return msg
+}
+
+// SetTimeouts sets how long WaitForPlugin waits for the plugin to register and to answer a ping, zero keeps
+// the default
+func (m *Manager) SetTimeouts(register, ping time.Duration) {
+	m.registerTimeout = register
+	m.pingTimeout = ping
+}
+
+// WaitForPlugin waits for the plugin to register and checks it answers a ping. Plugins that don't support
+// pings are ready once registered.
+func (m *Manager) WaitForPlugin(ctx context.Context, name string) (*RunningPlugin, error) {
+	timeout := m.registerTimeout
+	if timeout <= 0 {
+		timeout = DefaultRegisterTimeout
+	}
+	plg, err := m.waitForRegistration(ctx, name, timeout, func(plg RunningPlugin) bool {
+		return plg.Plugin.Config.Name == name
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.Ping(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return plg, nil
+}
+
+// waitForRegistration waits for a registered plugin accepted by match. It's woken up by Register, so it returns
+// as soon as the plugin registers, and fails early if the plugin started under the name exits and won't be
+// restarted.
+func (m *Manager) waitForRegistration(ctx context.Context, name string, timeout time.Duration, match func(RunningPlugin) bool) (*RunningPlugin, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		m.pluginsLock.Lock()
+		var found *RunningPlugin
+		for _, plg := range m.plugins {
+			if match(plg) {
+				plg := plg
+				found = &plg
+			}
+		}
+		registered := m.registeredNotify()
+		proc := m.processes[name]
+		m.pluginsLock.Unlock()
+
+		if found != nil {
+			return found, nil
+		}
+
+		var exited chan struct{}
+		if proc != nil && m.maxRestarts == 0 {
+			exited = proc.done
+		}
+		select {
+		case <-registered:
+		case <-exited:
+			return nil, &PluginExitError{
+				Name:     name,
+				ExitCode: proc.cmd.ProcessState.ExitCode(),
+				Stderr:   m.stderrTail(name),
+			}
+		case <-timer.C:
+			return nil, &PluginRegisterError{Name: name, Timeout: timeout, Stderr: m.stderrTail(name)}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// registeredNotify returns a channel closed when the next plugin registers, the caller holds pluginsLock
+func (m *Manager) registeredNotify() chan struct{} {
+	if m.registered == nil {
+		m.registered = make(chan struct{})
+	}
+	return m.registered
+}
+
+// Ping sends a ping to the running plugin and waits for its pong, returning the round trip time. Plugins that
+// don't support pings are not pinged.
+func (m *Manager) Ping(ctx context.Context, name string) (time.Duration, error) {
+	plg := m.GetPlugin(name)
+	if plg == nil {
+		return 0, fmt.Errorf("running plugin not found: %s", name)
+	}
+	if !plg.Capabilities.Has(golang.Capability_CAPABILITY_PING) {
+		return 0, nil
+	}
+	timeout := m.pingTimeout
+	if timeout <= 0 {
+		timeout = DefaultPingTimeout
+	}
+
+	m.pluginsLock.Lock()
+	if m.pings == nil {
+		m.pings = map[uint64]chan struct{}{}
+	}
+	m.pingNonce++
+	nonce := m.pingNonce
+	pong := make(chan struct{})
+	m.pings[nonce] = pong
+	m.pluginsLock.Unlock()
+	defer func() {
+		m.pluginsLock.Lock()
+		delete(m.pings, nonce)
+		m.pluginsLock.Unlock()
+	}()
+
+	sent := time.Now()
+	err := plg.Stream.Send(&golang.ServerMessage{
+		ServerMessage: &golang.ServerMessage_Ping{
+			Ping: &golang.Ping{Nonce: nonce},
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to ping plugin %s: %v", name, err)
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-pong:
+		return time.Since(sent), nil
+	case <-timer.C:
+		msg := fmt.Sprintf("plugin %s registered but did not answer a ping within %s", name, timeout)
+		if stderr := m.stderrTail(name); stderr != "" {
+			msg += ", last output:\n" + stderr
+		}
+		return 0, errors.New(msg)
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+func (m *Manager) handlePong(pong *golang.Pong) {
+	m.pluginsLock.Lock()
+	defer m.pluginsLock.Unlock()
+	if ch, ok := m.pings[pong.GetNonce()]; ok {
+		close(ch)
+		delete(m.pings, pong.GetNonce())
+	}
+}
+
+// markLogOffset remembers the size of the plugin's err logs before it's started, so the output of this run can
+// be shown when it fails
+func (m *Manager) markLogOffset(name string) int64 {
+	offset := logsSize(errLogsPath(name))
+
+	m.pluginsLock.Lock()
+	defer m.pluginsLock.Unlock()
+	if m.logOffsets == nil {
+		m.logOffsets = map[string]int64{}
+	}
+	m.logOffsets[name] = offset
+	return offset
+}
+
+// stderrTail returns the output of the plugin since it was last started, empty if it wasn't started by the manager
+func (m *Manager) stderrTail(name string) string {
+	m.pluginsLock.Lock()
+	offset, ok := m.logOffsets[name]
+	m.pluginsLock.Unlock()
+	if !ok {
+		return ""
+	}
+	return tailLogs(errLogsPath(name), offset)
+}
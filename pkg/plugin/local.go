@@ -0,0 +1,331 @@
+package plugin
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin/registry"
+	"github.com/kaytu-io/kaytu/pkg/server"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const (
+	localPluginPrefix   = "local-install-"
+	localStagingVersion = "staging"
+)
+
+// InstallFromFile installs a plugin from a local binary, a release archive (.tar.gz, .tgz, .zip)
+// or a directory containing the release assets, without reaching out to github.
+// If addr is empty the plugin name is taken from the RegisterConfig the plugin sends on startup.
+func (m *Manager) InstallFromFile(ctx context.Context, addr, path string) error {
+	cfg, err := server.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	plugins := map[string]*server.Plugin{}
+	for _, plg := range cfg.Plugins {
+		plugins[plg.Config.Name] = plg
+	}
+
+	binaryPath, cleanup, err := resolveLocalPlugin(path, cfg.PluginPublicKey)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	installName := localPluginPrefix + fmt.Sprint(os.Getpid())
+	if addr != "" {
+		addr = normalizePluginAddr(addr)
+		installName = addr
+		err = migrateLegacyPlugin(plugins[addr])
+		if err != nil {
+			return err
+		}
+	}
+	os.Stderr.WriteString(fmt.Sprintf("Installing plugin from %s\n", binaryPath))
+
+	// the binary is staged until the plugin reports its name and version
+	pluginExt := filepath.Ext(binaryPath)
+	if runtime.GOOS != "windows" {
+		pluginExt = ""
+	}
+	stagedPath := pluginBinaryPath(installName, localStagingVersion, pluginExt)
+	defer os.RemoveAll(filepath.Dir(stagedPath))
+	if installName != addr {
+		defer os.RemoveAll(server.PluginVersionsDir(installName))
+	}
+	err = copyPluginBinary(binaryPath, stagedPath)
+	if err != nil {
+		return err
+	}
+
+	plg, err := m.registerInstalled(ctx, installName, localStagingVersion)
+	if err != nil {
+		return err
+	}
+
+	if addr == "" {
+		addr = plg.Config.Name
+		err = migrateLegacyPlugin(plugins[addr])
+		if err != nil {
+			return err
+		}
+	}
+	installedVersion := plg.Config.Version
+	if installedVersion == "" {
+		installedVersion = "local"
+	}
+	target := pluginBinaryPath(addr, installedVersion, pluginExt)
+	err = os.MkdirAll(filepath.Dir(target), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(stagedPath, target)
+	if err != nil {
+		return err
+	}
+	syncDir(filepath.Dir(target))
+	plg.InstalledVersion = installedVersion
+	plugins[addr] = plg
+
+	err = pruneVersions(addr, installedVersion, cfg.PluginRetainedVersions)
+	if err != nil {
+		os.Stderr.WriteString(fmt.Sprintf("failed to remove old plugin versions due to %v\n", err))
+	}
+
+	cfg.Plugins = nil
+	for _, v := range plugins {
+		cfg.Plugins = append(cfg.Plugins, v)
+	}
+	err = server.SetConfig(*cfg)
+	if err != nil {
+		return err
+	}
+
+	os.Stderr.WriteString(fmt.Sprintf("Plugin %s, version %s installed\n", addr, plg.Config.Version))
+	return nil
+}
+
+// resolveLocalPlugin finds the plugin binary for the current platform within path. The returned cleanup func
+// removes any temporary files created while extracting archives.
+// When path is a directory of release assets, the asset is verified against the checksums file if present.
+func resolveLocalPlugin(path, publicKey string) (string, func(), error) {
+	noop := func() {}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", noop, err
+	}
+
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return "", noop, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && isPluginAsset(entry.Name()) {
+				err = verifyLocalAsset(path, entries, entry.Name(), publicKey)
+				if err != nil {
+					return "", noop, err
+				}
+				return resolveLocalPlugin(filepath.Join(path, entry.Name()), publicKey)
+			}
+		}
+		return "", noop, fmt.Errorf("no plugin asset found for %s/%s in %s", runtime.GOOS, runtime.GOARCH, path)
+	}
+
+	extract := archiveExtractor(path)
+	if extract == nil {
+		return path, noop, nil
+	}
+
+	dir, err := os.MkdirTemp("", "kaytu-plugin-")
+	if err != nil {
+		return "", noop, err
+	}
+	cleanup := func() {
+		os.RemoveAll(dir)
+	}
+
+	binaryPath, err := extract(path, dir)
+	if err != nil {
+		cleanup()
+		return "", noop, err
+	}
+	return binaryPath, cleanup, nil
+}
+
+func verifyLocalAsset(dir string, entries []os.DirEntry, assetName, publicKey string) error {
+	checksumsFile := ""
+	for _, entry := range entries {
+		if !entry.IsDir() && registry.IsChecksumsFile(entry.Name()) {
+			checksumsFile = filepath.Join(dir, entry.Name())
+		}
+	}
+	if checksumsFile == "" {
+		if publicKey != "" {
+			return fmt.Errorf("no checksums file found in %s", dir)
+		}
+		return nil
+	}
+
+	checksums, err := os.ReadFile(checksumsFile)
+	if err != nil {
+		return err
+	}
+	if publicKey != "" {
+		signature, err := os.ReadFile(checksumsFile + ".sig")
+		if err != nil {
+			return err
+		}
+		err = registry.VerifySignature(publicKey, checksums, signature)
+		if err != nil {
+			return err
+		}
+	}
+
+	f, err := os.Open(filepath.Join(dir, assetName))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, f)
+	if err != nil {
+		return err
+	}
+	return registry.VerifyChecksum(checksums, assetName, hash.Sum(nil))
+}
+
+// archiveExtractor returns the function extracting the plugin binary of the archive into a directory, nil if
+// name isn't an archive
+func archiveExtractor(name string) func(path, dir string) (string, error) {
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return extractTarGz
+	case strings.HasSuffix(name, ".zip"):
+		return extractZip
+	}
+	return nil
+}
+
+func extractTarGz(path, dir string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return "", err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if hdr.Typeflag != tar.TypeReg || !isPluginBinaryName(hdr.Name) {
+			continue
+		}
+		return writeExtracted(tr, filepath.Join(dir, filepath.Base(hdr.Name)))
+	}
+	return "", fmt.Errorf("no plugin binary found in %s", path)
+}
+
+func extractZip(path, dir string) (string, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return "", err
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || !isPluginBinaryName(f.Name) {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return "", err
+		}
+		defer rc.Close()
+		return writeExtracted(rc, filepath.Join(dir, filepath.Base(f.Name)))
+	}
+	return "", fmt.Errorf("no plugin binary found in %s", path)
+}
+
+func isPluginAsset(name string) bool {
+	_, ok := registry.MatchAsset(name)
+	return ok
+}
+
+// isPluginBinaryName skips the docs and license files shipped next to the binary in release archives
+func isPluginBinaryName(name string) bool {
+	base := strings.ToLower(filepath.Base(name))
+	switch {
+	case strings.HasPrefix(base, "readme"), strings.HasPrefix(base, "license"), strings.HasPrefix(base, "changelog"):
+		return false
+	case strings.HasSuffix(base, ".md"), strings.HasSuffix(base, ".txt"):
+		return false
+	}
+	return true
+}
+
+func writeExtracted(r io.Reader, target string) (string, error) {
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.ModePerm)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, r)
+	if err != nil {
+		return "", err
+	}
+	return target, nil
+}
+
+func copyPluginBinary(binaryPath, target string) error {
+	src, err := os.Open(binaryPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	err = os.MkdirAll(filepath.Dir(target), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	dst, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(dst, src)
+	if err != nil {
+		dst.Close()
+		return err
+	}
+	err = dst.Sync()
+	if err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
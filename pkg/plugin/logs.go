@@ -0,0 +1,52 @@
+package plugin
+
+import (
+	"fmt"
+	"github.com/kaytu-io/kaytu/controller"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
+	"os"
+	"time"
+)
+
+// SetLogs sets the controller the plugin logs are kept in for the logs page, it's shared with the sessions
+func (m *Manager) SetLogs(logs *controller.Logs) {
+	m.logs = logs
+	for _, s := range m.Sessions() {
+		s.logs = logs
+	}
+}
+
+// SetLogLevel prints the plugin logs with at least the given level to stderr, when they're not kept in a
+// logs controller. Unspecified turns it off.
+func (m *Manager) SetLogLevel(level golang.LogLevel) {
+	m.logLevel = level
+	for _, s := range m.Sessions() {
+		s.logLevel = level
+	}
+}
+
+func (m *Manager) publishLog(source string, entry *golang.LogEntry) {
+	if m.logs != nil {
+		m.logs.Add(source, entry)
+		return
+	}
+	if m.logLevel == golang.LogLevel_LOG_LEVEL_UNSPECIFIED || entry.GetLevel() < m.logLevel {
+		return
+	}
+
+	ts := time.Now()
+	if entry.GetTimestamp() != nil {
+		ts = entry.GetTimestamp().AsTime()
+	}
+	os.Stderr.WriteString(fmt.Sprintf("[%s] %s %-5s %s%s\n", source, ts.Local().Format(time.TimeOnly),
+		sdk.LogLevelName(entry.GetLevel()), entry.GetMessage(), sdk.FormatLogFields(entry.GetFields())))
+}
+
+// logSource is the name plugin logs are shown with, the session name if the plugin runs in one
+func (m *Manager) logSource(conf *golang.RegisterConfig) string {
+	if m.name != "" {
+		return m.name
+	}
+	return conf.GetName()
+}
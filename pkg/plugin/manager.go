@@ -2,48 +2,92 @@ package plugin
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
-	githubAPI "github.com/google/go-github/v62/github"
 	"github.com/kaytu-io/kaytu/controller"
 	"github.com/kaytu-io/kaytu/pkg/version"
 	"github.com/rogpeppe/go-internal/semver"
 	"github.com/schollz/progressbar/v3"
 	"io"
 	"net"
-	"net/http"
 	"os"
 	"path/filepath"
-	"regexp"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/plugin/registry"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
 	"github.com/kaytu-io/kaytu/pkg/server"
 	"github.com/kaytu-io/kaytu/view"
-	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
 )
 
-var (
-	patternVersionRegex, _ = regexp.Compile(fmt.Sprintf("plugin_([a-z0-9\\.]+)_%s_%s", runtime.GOOS, runtime.GOARCH))
-)
-
 type RunningPlugin struct {
 	Plugin server.Plugin
 	Stream golang.Plugin_RegisterServer
+	// Capabilities negotiated with the plugin when it registered
+	Capabilities sdk.Capabilities
+}
+
+// UsesCustomCharts reports whether the plugin's results are shown with its own chart definitions
+func (p *RunningPlugin) UsesCustomCharts() bool {
+	return p.Plugin.Config.DevicesChart != nil && p.Plugin.Config.OverviewChart != nil &&
+		p.Capabilities.Has(golang.Capability_CAPABILITY_CUSTOM_CHARTS)
 }
 
+// serverCapabilities are the protocol features the manager can handle
+var serverCapabilities = []golang.Capability{
+	golang.Capability_CAPABILITY_CUSTOM_CHARTS,
+	golang.Capability_CAPABILITY_SUMMARY_TABLE,
+	golang.Capability_CAPABILITY_NON_INTERACTIVE_EXPORT,
+	golang.Capability_CAPABILITY_CANCELLATION,
+	golang.Capability_CAPABILITY_STRUCTURED_ERRORS,
+	golang.Capability_CAPABILITY_JOB_PROGRESS,
+	golang.Capability_CAPABILITY_LOGS,
+	golang.Capability_CAPABILITY_PING,
+}
+
+const transportEnv = "KAYTU_PLUGIN_TRANSPORT"
+
 type Manager struct {
-	port    int
-	started bool
-	plugins []RunningPlugin
-	stream  golang.Plugin_RegisterServer
+	port        int
+	addr        string
+	token       string
+	started     bool
+	stopped     bool
+	plugins     []RunningPlugin
+	pluginsLock sync.Mutex
+	stream      golang.Plugin_RegisterServer
+
+	maxRestarts int
+	lastStart   map[string]*golang.StartProcess
+	processes   map[string]*pluginProcess
+	logOffsets  map[string]int64
+	recorder    *Recorder
+
+	// registered is closed when a plugin registers, see WaitForPlugin
+	registered      chan struct{}
+	registerTimeout time.Duration
+	pings           map[uint64]chan struct{}
+	pingNonce       uint64
+	pingTimeout     time.Duration
+
+	// sessions created with NewSession, a session keeps the manager it was created from as parent
+	sessions  []*Manager
+	parent    *Manager
+	name      string
+	sessionId string
+	ready     bool
 
 	golang.PluginServer
 
 	jobs                      *controller.Jobs
+	logs                      *controller.Logs
+	logLevel                  golang.LogLevel
 	optimizations             *controller.Optimizations[golang.OptimizationItem]
 	pluginCustomOptimizations *controller.Optimizations[golang.ChartOptimizationItem]
 
@@ -53,6 +97,7 @@ type Manager struct {
 	NonInteractiveView *view.NonInteractiveView
 	RootCommandView    *view.RootCommandView
 	lis                net.Listener
+	cleanupListener    func()
 	grpcServer         *grpc.Server
 }
 
@@ -68,7 +113,7 @@ func (m *Manager) SetListenPort(port int) {
 }
 
 func (m *Manager) GetPlugin(name string) *RunningPlugin {
-	for _, plg := range m.plugins {
+	for _, plg := range m.runningPlugins() {
 		if plg.Plugin.Config.Name == name {
 			return &plg
 		}
@@ -76,17 +121,48 @@ func (m *Manager) GetPlugin(name string) *RunningPlugin {
 	return nil
 }
 
+func (m *Manager) runningPlugins() []RunningPlugin {
+	m.pluginsLock.Lock()
+	defer m.pluginsLock.Unlock()
+	return append([]RunningPlugin(nil), m.plugins...)
+}
+
+func (m *Manager) addRunning(plg RunningPlugin) {
+	m.pluginsLock.Lock()
+	defer m.pluginsLock.Unlock()
+	m.plugins = append(m.plugins, plg)
+	if m.registered != nil {
+		close(m.registered)
+		m.registered = nil
+	}
+}
+
 func (m *Manager) StartPlugin(ctx context.Context, cmd string) error {
 	plugins, err := server.GetPlugins()
 	if err != nil {
 		return err
 	}
 
+	policy, err := LoadPolicy()
+	if err != nil {
+		return err
+	}
+
 	for _, plg := range plugins {
 		for _, c := range plg.Config.Commands {
 			if cmd == c.Name {
-				_, err := startPlugin(ctx, plg, fmt.Sprintf("localhost:%d", m.port))
-				return err
+				err = policy.Check(plg.Config.Name, plg.Config.Version)
+				if err != nil {
+					return err
+				}
+				logOffset := m.markLogOffset(plg.Config.Name)
+				runningCmd, err := startPlugin(ctx, plg, m.ServerAddr(), m.pluginEnv())
+				if err != nil {
+					return err
+				}
+				// tracked before supervising, so WaitForPlugin notices the plugin exiting early
+				go m.supervise(ctx, plg, m.trackProcess(plg.Config.Name, runningCmd), logOffset)
+				return nil
 			}
 		}
 	}
@@ -94,19 +170,41 @@ func (m *Manager) StartPlugin(ctx context.Context, cmd string) error {
 	return errors.New("plugin not found")
 }
 
+// StartServer listens for plugins on a private unix socket, falling back to localhost TCP where unix sockets
+// are not available, a fixed port is set (plugin debug mode) or KAYTU_PLUGIN_TRANSPORT=tcp is set.
+// Only plugins presenting the token generated for this run are accepted.
 func (m *Manager) StartServer() error {
 	var err error
 
-	m.lis, err = net.Listen("tcp", fmt.Sprintf("localhost:%d", m.port))
-	if err != nil {
-		return err
+	// plugins in debug mode (fixed port) are started by the developer, so they can't be given a token
+	if m.port == 0 {
+		m.token, err = newAuthToken()
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.port == 0 && os.Getenv(transportEnv) != "tcp" {
+		m.lis, m.addr, m.cleanupListener, err = listenPrivate()
+		if err != nil {
+			m.lis = nil
+		}
 	}
 
-	m.port = m.lis.Addr().(*net.TCPAddr).Port
+	if m.lis == nil {
+		m.lis, err = net.Listen("tcp", fmt.Sprintf("localhost:%d", m.port))
+		if err != nil {
+			return err
+		}
+
+		m.port = m.lis.Addr().(*net.TCPAddr).Port
+		m.addr = fmt.Sprintf("localhost:%d", m.port)
+	}
 
 	m.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(64*1024*1024),
 		grpc.MaxSendMsgSize(64*1024*1024),
+		grpc.StreamInterceptor(m.authenticate),
 	)
 	golang.RegisterPluginServer(m.grpcServer, m)
 	go func() {
@@ -118,31 +216,71 @@ func (m *Manager) StartServer() error {
 	return nil
 }
 
+// ServerAddr is passed to plugins with --server, either unix://<socket path> or localhost:<port>
+func (m *Manager) ServerAddr() string {
+	return m.addr
+}
+
+// AuthToken is the token plugins must present to register, empty in plugin debug mode
+func (m *Manager) AuthToken() string {
+	return m.token
+}
+
 func (m *Manager) StopServer() error {
+	m.pluginsLock.Lock()
+	m.stopped = true
+	m.pluginsLock.Unlock()
+
 	m.grpcServer.Stop()
-	return m.lis.Close()
+	err := m.lis.Close()
+	if m.cleanupListener != nil {
+		m.cleanupListener()
+	}
+	return err
 }
 
 func (m *Manager) Register(stream golang.Plugin_RegisterServer) error {
+	if session := m.sessionOf(stream.Context()); session != nil {
+		return session.Register(stream)
+	}
+
+	m.pluginsLock.Lock()
+	recorder := m.recorder
+	m.pluginsLock.Unlock()
+	if recorder != nil {
+		stream = &recordingStream{Plugin_RegisterServer: stream, recorder: recorder}
+	}
+	// every message sent to the plugin goes through the stream kept here and in its RunningPlugin
+	stream = &syncStream{Plugin_RegisterServer: stream}
 	m.stream = stream
+	// logs are shown with the name of the plugin, known once it sent its config
+	source := m.name
 	if m.RootCommandView != nil {
 		for {
 			receivedMsg, err := stream.Recv()
+			// the plugin closed its side after shutting down
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
 			if err != nil {
 				return err
 			}
 
 			switch {
 			case receivedMsg.GetConf() != nil:
-				conf := receivedMsg.GetConf()
-				m.plugins = append(m.plugins, RunningPlugin{
-					Plugin: server.Plugin{Config: conf},
-					Stream: stream,
-				})
+				err = m.register(receivedMsg.GetConf(), stream)
+				if err != nil {
+					return err
+				}
+				source = m.logSource(receivedMsg.GetConf())
+			case receivedMsg.GetLog() != nil:
+				m.publishLog(source, receivedMsg.GetLog())
+			case receivedMsg.GetPong() != nil:
+				m.handlePong(receivedMsg.GetPong())
 			case receivedMsg.GetJob() != nil:
-				m.RootCommandView.PublishJobs(receivedMsg.GetJob())
+				m.RootCommandView.PublishJobs(withErrorCode(receivedMsg.GetJob()))
 			case receivedMsg.GetErr() != nil:
-				m.RootCommandView.PublishError(fmt.Errorf(receivedMsg.GetErr().Error))
+				m.RootCommandView.PublishError(sdk.FromProto(receivedMsg.GetErr()))
 			case receivedMsg.GetReady() != nil:
 				m.RootCommandView.PublishResultsReady(receivedMsg.GetReady())
 			case receivedMsg.GetSummary() != nil:
@@ -152,25 +290,37 @@ func (m *Manager) Register(stream golang.Plugin_RegisterServer) error {
 	} else if m.NonInteractiveView != nil {
 		for {
 			receivedMsg, err := stream.Recv()
+			// the plugin closed its side after shutting down
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
 			if err != nil {
 				return err
 			}
 
 			switch {
 			case receivedMsg.GetConf() != nil:
-				conf := receivedMsg.GetConf()
-				m.plugins = append(m.plugins, RunningPlugin{
-					Plugin: server.Plugin{Config: conf},
-					Stream: stream,
-				})
+				err = m.register(receivedMsg.GetConf(), stream)
+				if err != nil {
+					return err
+				}
+				source = m.logSource(receivedMsg.GetConf())
+			case receivedMsg.GetLog() != nil:
+				m.publishLog(source, receivedMsg.GetLog())
+			case receivedMsg.GetPong() != nil:
+				m.handlePong(receivedMsg.GetPong())
 			case receivedMsg.GetOi() != nil:
-				m.NonInteractiveView.Optimizations.SendItem(receivedMsg.GetOi())
+				if m.NonInteractiveView.Optimizations != nil {
+					m.NonInteractiveView.Optimizations.SendItem(receivedMsg.GetOi())
+				}
 			case receivedMsg.GetCoi() != nil:
-				m.NonInteractiveView.PluginCustomOptimizations.SendItem(receivedMsg.GetCoi())
+				if m.NonInteractiveView.PluginCustomOptimizations != nil {
+					m.NonInteractiveView.PluginCustomOptimizations.SendItem(receivedMsg.GetCoi())
+				}
 			case receivedMsg.GetJob() != nil:
-				m.NonInteractiveView.PublishJobs(receivedMsg.GetJob())
+				m.NonInteractiveView.PublishJobs(withErrorCode(receivedMsg.GetJob()))
 			case receivedMsg.GetErr() != nil:
-				m.NonInteractiveView.PublishError(fmt.Errorf(receivedMsg.GetErr().Error))
+				m.NonInteractiveView.PublishError(sdk.FromProto(receivedMsg.GetErr()))
 			case receivedMsg.GetReady() != nil:
 				if m.optimizations != nil && receivedMsg.GetReady().GetReady() {
 					m.optimizations.SetInitialization(false)
@@ -178,13 +328,19 @@ func (m *Manager) Register(stream golang.Plugin_RegisterServer) error {
 				if m.pluginCustomOptimizations != nil && receivedMsg.GetReady().GetReady() {
 					m.pluginCustomOptimizations.SetInitialization(false)
 				}
+				// the view waits for its optimizations to be initialized, which they're not if no item was sent
+				if receivedMsg.GetReady().GetReady() {
+					if m.NonInteractiveView.Optimizations != nil {
+						m.NonInteractiveView.Optimizations.SetInitialization(false)
+					}
+					if m.NonInteractiveView.PluginCustomOptimizations != nil {
+						m.NonInteractiveView.PluginCustomOptimizations.SetInitialization(false)
+					}
+				}
 				m.NonInteractiveView.PublishResultsReady(receivedMsg.GetReady())
 			case receivedMsg.GetNonInteractive() != nil:
 				m.NonInteractiveView.PublishNonInteractiveExport(receivedMsg.GetNonInteractive())
 			case receivedMsg.GetUpdateChart() != nil:
-				if m.NonInteractiveView == nil {
-					return errors.New("custom optimizations controller not set - is plugin running in default ui mode?")
-				}
 				updateChart := receivedMsg.GetUpdateChart()
 				if updateChart.GetOverviewChart() != nil {
 					m.NonInteractiveView.SetChartDefinition(updateChart.GetOverviewChart())
@@ -197,6 +353,10 @@ func (m *Manager) Register(stream golang.Plugin_RegisterServer) error {
 	} else {
 		for {
 			receivedMsg, err := stream.Recv()
+			// the plugin closed its side after shutting down
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
 			if err != nil {
 				if m.jobs != nil {
 					m.jobs.PublishError(err)
@@ -206,26 +366,30 @@ func (m *Manager) Register(stream golang.Plugin_RegisterServer) error {
 
 			switch {
 			case receivedMsg.GetConf() != nil:
-				conf := receivedMsg.GetConf()
-				m.plugins = append(m.plugins, RunningPlugin{
-					Plugin: server.Plugin{Config: conf},
-					Stream: stream,
-				})
+				err = m.register(receivedMsg.GetConf(), stream)
+				if err != nil {
+					return err
+				}
+				source = m.logSource(receivedMsg.GetConf())
+			case receivedMsg.GetLog() != nil:
+				m.publishLog(source, receivedMsg.GetLog())
+			case receivedMsg.GetPong() != nil:
+				m.handlePong(receivedMsg.GetPong())
 			case receivedMsg.GetJob() != nil:
-				m.jobs.Publish(receivedMsg.GetJob())
+				m.jobs.Publish(withErrorCode(receivedMsg.GetJob()))
+			// messages meant for the other kind of ui are dropped, e.g. charts sent by a plugin the CLI
+			// didn't negotiate custom charts with
 			case receivedMsg.GetOi() != nil:
-				if m.optimizations == nil {
-					return errors.New("default optimizations controller not set - is plugin running in custom ui mode?")
+				if m.optimizations != nil {
+					m.optimizations.SendItemFrom(m.name, receivedMsg.GetOi())
 				}
-				m.optimizations.SendItem(receivedMsg.GetOi())
 			case receivedMsg.GetCoi() != nil:
-				if m.pluginCustomOptimizations == nil {
-					return errors.New("custom optimizations controller not set - is plugin running in default ui mode?")
+				if m.pluginCustomOptimizations != nil {
+					m.pluginCustomOptimizations.SendItemFrom(m.name, receivedMsg.GetCoi())
 				}
-				m.pluginCustomOptimizations.SendItem(receivedMsg.GetCoi())
 			case receivedMsg.GetUpdateChart() != nil:
 				if m.pluginCustomOptimizations == nil {
-					return errors.New("custom optimizations controller not set - is plugin running in default ui mode?")
+					continue
 				}
 				updateChart := receivedMsg.GetUpdateChart()
 				if updateChart.GetOverviewChart() != nil && m.overviewPage != nil {
@@ -235,206 +399,357 @@ func (m *Manager) Register(stream golang.Plugin_RegisterServer) error {
 					m.detailsPage.SetChartDefinition(updateChart.GetDevicesChart())
 				}
 			case receivedMsg.GetReady() != nil:
-				if m.optimizations != nil && receivedMsg.GetReady().GetReady() {
+				if !receivedMsg.GetReady().GetReady() || !m.markReady() {
+					continue
+				}
+				if m.optimizations != nil {
 					m.optimizations.SetInitialization(false)
 				}
-				if m.pluginCustomOptimizations != nil && receivedMsg.GetReady().GetReady() {
+				if m.pluginCustomOptimizations != nil {
 					m.pluginCustomOptimizations.SetInitialization(false)
 				}
 			case receivedMsg.GetErr() != nil:
 				if m.jobs != nil {
-					m.jobs.PublishError(fmt.Errorf(receivedMsg.GetErr().Error))
+					m.jobs.PublishError(sdk.FromProto(receivedMsg.GetErr()))
 				}
 			case receivedMsg.GetSummary() != nil:
-				if m.pluginCustomOptimizations == nil {
-					return errors.New("default optimizations controller not set - is plugin running in custom ui mode?")
+				if m.pluginCustomOptimizations != nil {
+					m.pluginCustomOptimizations.SetResultSummary(receivedMsg.GetSummary().Message)
 				}
-				m.pluginCustomOptimizations.SetResultSummary(receivedMsg.GetSummary().Message)
-
 			case receivedMsg.GetSummaryTable() != nil:
-				if m.pluginCustomOptimizations == nil {
-					return errors.New("default optimizations controller not set - is plugin running in custom ui mode?")
+				if m.pluginCustomOptimizations != nil {
+					m.pluginCustomOptimizations.SetResultSummaryTable(receivedMsg.GetSummaryTable())
 				}
-				m.pluginCustomOptimizations.SetResultSummaryTable(receivedMsg.GetSummaryTable())
 			}
 		}
 	}
 }
 
-func (m *Manager) Install(ctx context.Context, addr, token string, unsafe, pluginDebugMode bool) error {
-	cfg, err := server.GetConfig()
+// register answers the RegisterConfig of a plugin with a ServerHello and adds it to the running plugins.
+// The hello is sent before the plugin is visible to callers of GetPlugin, so it is the first message the plugin
+// gets.
+func (m *Manager) register(conf *golang.RegisterConfig, stream golang.Plugin_RegisterServer) error {
+	err := stream.Send(&golang.ServerMessage{
+		ServerMessage: &golang.ServerMessage_Hello{
+			Hello: &golang.ServerHello{
+				ProtocolVersion: sdk.ProtocolVersion,
+				Capabilities:    serverCapabilities,
+				KaytuVersion:    version.VERSION,
+			},
+		},
+	})
 	if err != nil {
 		return err
 	}
 
-	if !strings.HasPrefix(addr, "github.com") {
-		addr = fmt.Sprintf("github.com/kaytu-io/plugin-%s", addr)
-	}
-	addr = strings.TrimPrefix(addr, "github.com/")
-	owner, repository, _ := strings.Cut(addr, "/")
+	m.addRunning(RunningPlugin{
+		Plugin:       server.Plugin{Config: conf},
+		Stream:       stream,
+		Capabilities: sdk.Negotiate(serverCapabilities, conf.GetProtocolVersion(), conf.GetCapabilities()),
+	})
+	return nil
+}
 
-	var tc *http.Client
-	if token != "" {
-		ts := oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: token},
-		)
-		tc = oauth2.NewClient(context.Background(), ts)
-	}
-	approved, err := m.isPluginApproved(tc, addr)
+func (m *Manager) Install(ctx context.Context, addr, token string, unsafe, pluginDebugMode bool) error {
+	cfg, err := server.GetConfig()
 	if err != nil {
 		return err
 	}
 
-	if !approved && !unsafe {
-		return fmt.Errorf("plugin not approved. either use --unsafe or make a pull request on github.com/kaytu-io/kaytu to approve your plugin")
+	// a specific release can be requested with <plugin>@<version>, such plugins are pinned to that version
+	addr, requestedVersion, _ := strings.Cut(addr, "@")
+	addr = normalizePluginAddr(addr)
+	if requestedVersion != "" && !strings.HasPrefix(requestedVersion, "v") {
+		requestedVersion = "v" + requestedVersion
 	}
+	pinned := requestedVersion != ""
 
-	api := githubAPI.NewClient(tc)
 	plugins := map[string]*server.Plugin{}
 	for _, plg := range cfg.Plugins {
 		plugins[plg.Config.Name] = plg
 	}
 
-	var release *githubAPI.RepositoryRelease
-	if !pluginDebugMode {
-		release, _, err = api.Repositories.GetLatestRelease(context.Background(), owner, repository)
+	policy, err := LoadPolicy()
+	if err != nil {
+		return err
+	}
+
+	if pluginDebugMode {
+		registered, err := m.waitForRegistration(ctx, addr, installRegisterTimeout, func(plg RunningPlugin) bool {
+			return plg.Plugin.Config.Name == addr
+		})
 		if err != nil {
 			return err
 		}
-	} else {
-		release = &githubAPI.RepositoryRelease{}
-		installed := false
-		for i := 0; i < 30; i++ {
-			for _, runningPlugin := range m.plugins {
-				if runningPlugin.Plugin.Config.Name == addr {
-					installed = true
-				}
-			}
-
-			if installed {
-				break
-			}
-			time.Sleep(time.Second)
-		}
-
-		if !installed {
-			return errors.New("plugin install timeout")
+		err = policy.Check(registered.Plugin.Config.Name, registered.Plugin.Config.Version)
+		if err != nil {
+			return err
 		}
 
-		plugins[addr] = &m.GetPlugin(addr).Plugin
+		plugins[addr] = &registered.Plugin
+		return savePlugins(cfg, plugins)
 	}
 
-	for _, asset := range release.Assets {
-		if asset.ID != nil && asset.Name != nil && patternVersionRegex.MatchString(*asset.Name) {
-			assetVersion := strings.Split(*asset.Name, "_")[1]
-			if p, ok := plugins[addr]; ok && p.Config.Version == assetVersion {
-				return nil
-			}
-			os.Stderr.WriteString(fmt.Sprintf("Installing plugin %s, version %s\n", addr, assetVersion))
-			os.Stderr.WriteString("Downloading the plugin...\n")
+	err = policy.Check(addr, requestedVersion)
+	if err != nil {
+		return err
+	}
 
-			rc, url, err := api.Repositories.DownloadReleaseAsset(context.Background(), owner, repository, *asset.ID, nil)
-			if err != nil {
-				return err
-			}
+	registries, err := registry.FromConfig(cfg.Registries, token, cfg.PluginPublicKey)
+	if err != nil {
+		return err
+	}
+	reg, release, err := registry.Find(ctx, registries, addr, requestedVersion)
+	if err != nil {
+		if errors.Is(err, registry.ErrNotFound) {
+			return fmt.Errorf("plugin %s not found in any registry", addr)
+		}
+		return err
+	}
+	err = policy.Check(addr, release.Version)
+	if err != nil {
+		return err
+	}
 
-			if len(url) > 0 {
-				resp, err := http.Get(url)
-				if err != nil {
-					return err
-				}
+	// plugins allowed by the organization policy don't need to be approved upstream
+	approved := policy != nil
+	if !approved {
+		approved, err = reg.IsApproved(ctx, addr)
+		if err != nil {
+			return err
+		}
+	}
+	if !approved && !unsafe {
+		return fmt.Errorf("plugin not approved. either use --unsafe or make a pull request on github.com/kaytu-io/kaytu to approve your plugin")
+	}
 
-				defer resp.Body.Close()
+	// the pin is only changed when a version is requested, plugins are unpinned with plugin unpin
+	if p, ok := plugins[addr]; ok && p.Config.Version == release.Version {
+		if !pinned || p.Pinned {
+			return nil
+		}
+		p.Pinned = true
+		return savePlugins(cfg, plugins)
+	}
 
-				rc = resp.Body
-			}
+	os.Stderr.WriteString(fmt.Sprintf("Installing plugin %s, version %s\n", addr, release.Version))
+	if release.VerifyErr != nil {
+		if !unsafe {
+			return fmt.Errorf("failed to verify plugin release: %v. use --unsafe to skip verification", release.VerifyErr)
+		}
+		os.Stderr.WriteString(fmt.Sprintf("skipping plugin verification: %v\n", release.VerifyErr))
+	}
 
-			os.MkdirAll(server.PluginDir(), os.ModePerm)
+	os.Stderr.WriteString("Downloading the plugin...\n")
+	rc, err := reg.Download(ctx, release)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
 
-			pluginExt := filepath.Ext(*asset.Name)
-			if runtime.GOOS != "windows" {
-				pluginExt = ""
-			}
-			f, err := os.OpenFile(filepath.Join(server.PluginDir(), strings.ReplaceAll(addr, "/", "_")+pluginExt), os.O_CREATE|os.O_RDWR, os.ModePerm)
-			if err != nil {
-				return err
-			}
+	err = migrateLegacyPlugin(plugins[addr])
+	if err != nil {
+		return err
+	}
 
-			bar := progressbar.DefaultBytes(int64(asset.GetSize()))
-			_, err = io.Copy(io.MultiWriter(f, bar), rc)
-			if err != nil {
-				return err
-			}
+	versionDir := filepath.Dir(pluginBinaryPath(addr, release.Version, ""))
+	err = os.MkdirAll(versionDir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	// the binary is downloaded next to its final path and only moved there once verified
+	f, err := os.CreateTemp(versionDir, filepath.Base(pluginBinaryPath(addr, release.Version, ""))+".download-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
 
-			err = f.Close()
-			if err != nil {
-				return err
-			}
+	hash := sha256.New()
+	size := release.Size
+	if size <= 0 {
+		size = -1
+	}
+	bar := progressbar.DefaultBytes(size)
+	written, err := io.Copy(io.MultiWriter(f, hash, bar), rc)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	if release.Size > 0 && written != release.Size {
+		f.Close()
+		return fmt.Errorf("incomplete plugin download: got %d of %d bytes", written, release.Size)
+	}
 
-			plugin := server.Plugin{
-				Config: &golang.RegisterConfig{
-					Name:     addr,
-					Version:  "",
-					Provider: "",
-					Commands: nil,
-				},
-			}
-			os.Stderr.WriteString("Starting the plugin...\n")
-			runningCmd, err := startPlugin(ctx, &plugin, fmt.Sprintf("localhost:%d", m.port))
-			if err != nil {
-				return err
-			}
-			defer runningCmd.Process.Kill()
-			defer func() {
-				m.plugins = nil
-			}()
-
-			os.Stderr.WriteString("Waiting for plugin to load...\n")
-			installed := false
-			for i := 0; i < 30; i++ {
-				for _, runningPlugin := range m.plugins {
-					if runningPlugin.Plugin.Config.Name == addr {
-						installed = true
-					}
-				}
+	err = f.Sync()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
 
-				if installed {
-					break
-				}
-				time.Sleep(time.Second)
-			}
+	if release.SHA256 != "" {
+		err = registry.CompareChecksum(release.AssetName, release.SHA256, hash.Sum(nil))
+		if err != nil {
+			return err
+		}
+	}
 
-			if !installed {
-				return errors.New("plugin install timeout")
-			}
+	// the checksum is of the archive, the binary it holds is installed
+	binaryPath, binaryName := f.Name(), release.AssetName
+	if extract := archiveExtractor(release.AssetName); extract != nil {
+		extractDir, err := os.MkdirTemp(versionDir, "extract-*")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(extractDir)
+		binaryPath, err = extract(f.Name(), extractDir)
+		if err != nil {
+			return err
+		}
+		binaryName = binaryPath
+	}
 
-			plugins[addr] = &m.GetPlugin(addr).Plugin
+	pluginExt := filepath.Ext(binaryName)
+	if runtime.GOOS != "windows" {
+		pluginExt = ""
+	}
+	target := pluginBinaryPath(addr, release.Version, pluginExt)
+	err = os.Chmod(binaryPath, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(binaryPath, target)
+	if err != nil {
+		return err
+	}
+	syncDir(filepath.Dir(target))
 
-			if semver.Compare("v"+version.VERSION, plugins[addr].Config.MinKaytuVersion) == -1 {
-				return fmt.Errorf("plugin requires kaytu version %s, please update your Kaytu CLI", plugins[addr].Config.MinKaytuVersion)
-			}
-			break
+	// the config keeps pointing to the previous version until the new one registers successfully
+	plg, err := m.registerInstalled(ctx, addr, release.Version)
+	if err != nil {
+		if p, ok := plugins[addr]; !ok || p.InstalledVersion != release.Version {
+			os.RemoveAll(filepath.Dir(target))
 		}
+		return err
+	}
+	plg.Pinned = pinned
+	if p, ok := plugins[addr]; ok && !pinned {
+		plg.Pinned = p.Pinned
+	}
+	plugins[addr] = plg
+
+	err = pruneVersions(addr, release.Version, cfg.PluginRetainedVersions)
+	if err != nil {
+		os.Stderr.WriteString(fmt.Sprintf("failed to remove old plugin versions due to %v\n", err))
 	}
 
+	return savePlugins(cfg, plugins)
+}
+
+func savePlugins(cfg *server.Config, plugins map[string]*server.Plugin) error {
 	cfg.Plugins = nil
 	for _, v := range plugins {
 		cfg.Plugins = append(cfg.Plugins, v)
 	}
-	err = server.SetConfig(*cfg)
+	return server.SetConfig(*cfg)
+}
+
+// registerInstalled starts the given installed version of the plugin and waits for it to send its RegisterConfig
+func (m *Manager) registerInstalled(ctx context.Context, addr, installedVersion string) (*server.Plugin, error) {
+	plugin := server.Plugin{
+		Config: &golang.RegisterConfig{
+			Name:     addr,
+			Version:  "",
+			Provider: "",
+			Commands: nil,
+		},
+		InstalledVersion: installedVersion,
+	}
+	os.Stderr.WriteString("Starting the plugin...\n")
+	m.markLogOffset(addr)
+	runningCmd, err := startPlugin(ctx, &plugin, m.ServerAddr(), m.pluginEnv())
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer runningCmd.Process.Kill()
+	defer func() {
+		m.pluginsLock.Lock()
+		m.plugins = nil
+		m.pluginsLock.Unlock()
+	}()
 
-	return nil
+	os.Stderr.WriteString("Waiting for plugin to load...\n")
+	// plugins installed from a local file may not be named yet, in that case any plugin is accepted
+	registered, err := m.waitForRegistration(ctx, addr, installRegisterTimeout, func(plg RunningPlugin) bool {
+		return plg.Plugin.Config.Name == addr || strings.HasPrefix(addr, localPluginPrefix)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if semver.Compare("v"+version.VERSION, registered.Plugin.Config.MinKaytuVersion) == -1 {
+		return nil, fmt.Errorf("plugin requires kaytu version %s, please update your Kaytu CLI", registered.Plugin.Config.MinKaytuVersion)
+	}
+
+	policy, err := LoadPolicy()
+	if err != nil {
+		return nil, err
+	}
+	err = policy.Check(registered.Plugin.Config.Name, registered.Plugin.Config.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	registered.Plugin.InstalledVersion = installedVersion
+	return &registered.Plugin, nil
 }
 
+// LatestVersion returns the version of the latest release of the plugin for the current platform
+func (m *Manager) LatestVersion(ctx context.Context, addr, token string) (string, error) {
+	cfg, err := server.GetConfig()
+	if err != nil {
+		return "", err
+	}
+
+	registries, err := registry.FromConfig(cfg.Registries, token, cfg.PluginPublicKey)
+	if err != nil {
+		return "", err
+	}
+	_, release, err := registry.Find(ctx, registries, normalizePluginAddr(addr), "")
+	if err != nil {
+		return "", err
+	}
+	return release.Version, nil
+}
+
+// normalizePluginAddr turns a short plugin name (e.g. aws) or a github address into owner/repository form
+func normalizePluginAddr(addr string) string {
+	addr = strings.TrimPrefix(addr, "github.com/")
+	if !strings.Contains(addr, "/") {
+		addr = fmt.Sprintf("kaytu-io/plugin-%s", addr)
+	}
+	return addr
+}
+
+// SetDefaultUI sets the controllers the plugin results are shown with, they're shared with the manager's
+// sessions and re-evaluations are sent to the session the item came from. Sessions given their own ui with
+// SetCustomUI before keep it.
 func (m *Manager) SetDefaultUI(jobs *controller.Jobs, optimizations *controller.Optimizations[golang.OptimizationItem]) {
 	m.jobs = jobs
 	m.optimizations = optimizations
+	for _, s := range m.Sessions() {
+		if s.pluginCustomOptimizations != nil {
+			continue
+		}
+		s.jobs = jobs
+		s.optimizations = optimizations
+	}
 
-	optimizations.SetReEvaluateFunc(func(id string, items []*golang.PreferenceItem) {
-		m.stream.Send(&golang.ServerMessage{
+	optimizations.SetReEvaluateFunc(func(source, id string, items []*golang.PreferenceItem) {
+		m.sessionNamed(source).stream.Send(&golang.ServerMessage{
 			ServerMessage: &golang.ServerMessage_ReEvaluate{
 				ReEvaluate: &golang.ReEvaluate{
 					Id:          id,
@@ -452,7 +767,7 @@ func (m *Manager) SetCustomUI(jobs *controller.Jobs, optimizations *controller.O
 	m.overviewPage = overviewPage
 	m.detailsPage = detailsPage
 
-	optimizations.SetReEvaluateFunc(func(id string, items []*golang.PreferenceItem) {
+	optimizations.SetReEvaluateFunc(func(source, id string, items []*golang.PreferenceItem) {
 		m.stream.Send(&golang.ServerMessage{
 			ServerMessage: &golang.ServerMessage_ReEvaluate{
 				ReEvaluate: &golang.ReEvaluate{
@@ -472,31 +787,45 @@ func (m *Manager) SetRootCommandView() {
 	m.RootCommandView = view.NewRootCommandView()
 }
 
-func (m *Manager) isPluginApproved(tc *http.Client, pluginName string) (bool, error) {
-	if pluginName == "kaytu-io/plugin-aws" {
-		return true, nil
+// ShowsCustomCharts reports whether the results of the installed plugin are shown with its own chart
+// definitions, as RunningPlugin.UsesCustomCharts does once it runs
+func ShowsCustomCharts(plg *server.Plugin) bool {
+	running := RunningPlugin{
+		Plugin:       *plg,
+		Capabilities: sdk.Negotiate(serverCapabilities, plg.Config.GetProtocolVersion(), plg.Config.GetCapabilities()),
 	}
-	api := githubAPI.NewClient(tc)
-	fileContent, _, resp, err := api.Repositories.GetContents(context.Background(), "kaytu-io", "kaytu", "approved_plugins", nil)
+	return running.UsesCustomCharts()
+}
+
+// InstalledPlugin returns the installed plugin with the given name, short names (e.g. aws) are accepted
+func InstalledPlugin(pluginName string) (*server.Plugin, error) {
+	plugins, err := server.GetPlugins()
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	if resp.StatusCode != 200 {
-		return false, fmt.Errorf("invalid status code: %d", resp.StatusCode)
+	for _, plg := range plugins {
+		if plg.Config.Name == pluginName || plg.Config.Name == normalizePluginAddr(pluginName) {
+			return plg, nil
+		}
 	}
+	return nil, fmt.Errorf("plugin not found")
+}
 
-	content, err := fileContent.GetContent()
+// SetPinned marks an installed plugin as pinned, pinned plugins are not updated automatically
+func (m *Manager) SetPinned(pluginName string, pinned bool) error {
+	cfg, err := server.GetConfig()
 	if err != nil {
-		return false, err
+		return err
 	}
-	plugins := strings.Split(content, "\n")
-	for _, plugin := range plugins {
-		if plugin == pluginName {
-			return true, nil
+
+	for _, plg := range cfg.Plugins {
+		if plg.Config.Name == pluginName || plg.Config.Name == normalizePluginAddr(pluginName) {
+			plg.Pinned = pinned
+			return server.SetConfig(*cfg)
 		}
 	}
-	return false, nil
+	return fmt.Errorf("plugin not found")
 }
 
 func (m *Manager) Uninstall(pluginName string) error {
@@ -519,9 +848,12 @@ func (m *Manager) Uninstall(pluginName string) error {
 		return fmt.Errorf("plugin not found")
 	}
 
-	pluginFile := filepath.Join(server.PluginDir(), strings.ReplaceAll(pluginName, "/", "_"))
-
-	err = os.Remove(pluginFile)
+	legacyPlugin := server.Plugin{Config: &golang.RegisterConfig{Name: pluginName}}
+	err = os.RemoveAll(legacyPlugin.Path())
+	if err != nil {
+		return err
+	}
+	err = os.RemoveAll(server.PluginVersionsDir(pluginName))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,43 @@
+package plugin_test
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/kaytu-io/kaytu/pkg/plugin/plugintest"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestReEvaluate(t *testing.T) {
+	plg := plugintest.NewPlugin("fake", "ec2-instance").Script("ec2-instance",
+		plugintest.Item(&golang.OptimizationItem{Id: "i-0123", Name: "web", ResourceType: "t3.large"}),
+		plugintest.Ready(),
+	)
+	plg.OnReEvaluate = func(evaluate *golang.ReEvaluate) []*golang.PluginMessage {
+		return []*golang.PluginMessage{
+			plugintest.Item(&golang.OptimizationItem{Id: evaluate.GetId(), Name: "web", ResourceType: "t3.medium"}),
+		}
+	}
+	h := plugintest.New(t, plg, true)
+	h.Start("ec2-instance", map[string]string{"region": "us-east-1"})
+	h.WaitForItems(1)
+
+	prefs := []*golang.PreferenceItem{{Service: "EC2Instance", Key: "vCPU", Value: &wrappers.StringValue{Value: "2"}, Pinned: true}}
+	h.Optimizations.ReEvaluate(h.Optimizations.Items()[0], prefs)
+
+	reEvaluates := plg.WaitForReEvaluates(t, 1, 5*time.Second)
+	assert.Equal(t, "i-0123", reEvaluates[0].GetId())
+	assert.Len(t, reEvaluates[0].GetPreferences(), 1)
+	assert.Equal(t, "2", reEvaluates[0].GetPreferences()[0].GetValue().GetValue())
+	assert.True(t, reEvaluates[0].GetPreferences()[0].GetPinned())
+
+	assert.Eventually(t, func() bool {
+		items := h.Optimizations.Items()
+		return !h.Optimizations.IsProcessing() && len(items) == 1 && items[0].ResourceType == "t3.medium"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	starts := plg.Starts()
+	assert.Len(t, starts, 1)
+	assert.Equal(t, "us-east-1", starts[0].GetFlags()["region"])
+}
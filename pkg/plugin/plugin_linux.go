@@ -4,30 +4,40 @@ package plugin
 
 import (
 	"context"
-	"fmt"
 	"github.com/kaytu-io/kaytu/pkg/server"
 	"os"
 	"os/exec"
-	"path/filepath"
-	"strings"
 	"syscall"
 )
 
-func startPlugin(ctx context.Context, plg *server.Plugin, serverAddr string) (*exec.Cmd, error) {
-	logsDir := server.LogsDir()
-	cmd := exec.CommandContext(ctx, plg.Path(), "--server", serverAddr)
+// pluginCommand returns the command running the plugin binary against the CLI, it's killed when the CLI exits
+func pluginCommand(ctx context.Context, path, serverAddr string, env []string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, path, "--server", serverAddr)
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 	cmd.SysProcAttr = &syscall.SysProcAttr{
 		Pdeathsig: syscall.SIGKILL,
 	}
+	return cmd
+}
+
+func startPlugin(ctx context.Context, plg *server.Plugin, serverAddr string, env []string) (*exec.Cmd, error) {
+	cmd := pluginCommand(ctx, plg.Path(), serverAddr, env)
 
-	errLogs, err := os.OpenFile(filepath.Join(logsDir, fmt.Sprintf("%s.err.logs", strings.ReplaceAll(plg.Config.Name, "/", "_"))), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	// a failed rotation doesn't keep the plugin from starting, it's tried again on the next start
+	errLogsPath := server.PluginLogsPath(plg.Config.Name, "err")
+	_ = server.RotateLogs(errLogsPath)
+	errLogs, err := os.OpenFile(errLogsPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
 		return nil, err
 	}
 	defer errLogs.Close()
 	cmd.Stderr = errLogs
 
-	outLogs, err := os.OpenFile(filepath.Join(logsDir, fmt.Sprintf("%s.out.logs", strings.ReplaceAll(plg.Config.Name, "/", "_"))), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	outLogsPath := server.PluginLogsPath(plg.Config.Name, "out")
+	_ = server.RotateLogs(outLogsPath)
+	outLogs, err := os.OpenFile(outLogsPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,37 @@
+package plugintest
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files instead of comparing with them")
+
+// AssertGolden compares got with testdata/<name>.golden, which is written instead when the tests are run with
+// -update
+func AssertGolden(t testing.TB, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+
+	if *update {
+		err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+		if err != nil {
+			t.Fatalf("failed to create testdata: %v", err)
+		}
+		err = os.WriteFile(path, []byte(got), 0644)
+		if err != nil {
+			t.Fatalf("failed to update %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read %s, run the tests with -update to create it: %v", path, err)
+	}
+	if got != string(want) {
+		t.Errorf("output differs from %s, run the tests with -update if the change is expected\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
@@ -0,0 +1,159 @@
+package plugintest
+
+import (
+	"context"
+	"errors"
+	"github.com/kaytu-io/kaytu/controller"
+	"github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
+	"github.com/kaytu-io/kaytu/view"
+	"testing"
+	"time"
+)
+
+// Timeout is how long the harness waits for the plugin to register and for its results
+var Timeout = 10 * time.Second
+
+// Harness is a manager with the fake plugin registered, the plugin and the server are stopped when the test ends
+type Harness struct {
+	Manager *plugin.Manager
+	Plugin  *Plugin
+	Running *plugin.RunningPlugin
+
+	// Jobs and the optimizations controllers get the results shown by the interactive view, the one matching
+	// the plugin is set
+	Jobs                *controller.Jobs
+	Optimizations       *controller.Optimizations[golang.OptimizationItem]
+	CustomOptimizations *controller.Optimizations[golang.ChartOptimizationItem]
+
+	t testing.TB
+}
+
+// New starts a manager and connects the fake plugin to it. If interactive, the results go to the controllers
+// of the harness like they go to the views of the TUI, otherwise they go to the manager's NonInteractiveView.
+func New(t testing.TB, plg *Plugin, interactive bool) *Harness {
+	t.Helper()
+
+	manager := plugin.New()
+	if !interactive {
+		manager.SetNonInteractiveView(false)
+	}
+	err := manager.StartServer()
+	if err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	exited := make(chan error, 1)
+	go func() {
+		exited <- sdk.New(plg, 1).Run(ctx, manager.ServerAddr(), manager.AuthToken(), "")
+	}()
+	t.Cleanup(func() {
+		manager.Shutdown("test finished", time.Second)
+		cancel()
+		manager.StopServer()
+		<-exited
+	})
+
+	running, err := manager.WaitForPlugin(ctx, plg.Config.Name)
+	if err != nil {
+		t.Fatalf("fake plugin failed to register: %v", err)
+	}
+
+	h := &Harness{Manager: manager, Plugin: plg, Running: running, t: t}
+	config := running.Plugin.Config
+	switch {
+	case interactive && running.UsesCustomCharts():
+		h.Jobs = controller.NewJobs()
+		h.CustomOptimizations = controller.NewOptimizations[golang.ChartOptimizationItem]()
+		manager.SetCustomUI(h.Jobs, h.CustomOptimizations, nil, nil)
+	case interactive:
+		h.Jobs = controller.NewJobs()
+		h.Optimizations = controller.NewOptimizations[golang.OptimizationItem]()
+		manager.SetDefaultUI(h.Jobs, h.Optimizations)
+	case running.UsesCustomCharts():
+		manager.NonInteractiveView.SetOptimizations(nil, controller.NewOptimizations[golang.ChartOptimizationItem](),
+			config.OverviewChart, config.DevicesChart)
+	default:
+		manager.NonInteractiveView.SetOptimizations(controller.NewOptimizations[golang.OptimizationItem](),
+			nil, nil, nil)
+	}
+	return h
+}
+
+// Start sends the command to the fake plugin, which answers with the messages scripted for it
+func (h *Harness) Start(command string, flags map[string]string) {
+	h.t.Helper()
+	err := h.Manager.SendStart(h.Plugin.Config.Name, &golang.StartProcess{
+		Command: command,
+		Flags:   flags,
+	})
+	if err != nil {
+		h.t.Fatalf("failed to start %s: %v", command, err)
+	}
+}
+
+// Wait waits for the NonInteractiveView to get all the results, it returns the error reported by the plugin
+// instead, if any
+func (h *Harness) Wait() error {
+	h.t.Helper()
+	if h.Manager.NonInteractiveView == nil {
+		h.t.Fatalf("the results of an interactive harness are sent to its controllers")
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- h.Manager.NonInteractiveView.WaitForResults()
+	}()
+	select {
+	case err := <-done:
+		var resultsErr *view.ResultsError
+		if errors.As(err, &resultsErr) {
+			return resultsErr.Err
+		}
+		return err
+	case <-time.After(Timeout):
+		h.t.Fatalf("no results within %s", Timeout)
+		return nil
+	}
+}
+
+// Results waits for the results and renders them in the given format (table, csv or json), the test fails if
+// the plugin reports an error
+func (h *Harness) Results(format string) string {
+	h.t.Helper()
+	err := h.Wait()
+	if err != nil {
+		h.t.Fatalf("plugin failed: %v", err)
+	}
+	out, err := h.Manager.NonInteractiveView.RenderResults(format)
+	if err != nil {
+		h.t.Fatalf("failed to render %s results: %v", format, err)
+	}
+	return out
+}
+
+// WaitForItems waits for the interactive controllers to have n optimization items
+func (h *Harness) WaitForItems(n int) {
+	h.t.Helper()
+	deadline := time.Now().Add(Timeout)
+	for {
+		count := 0
+		switch {
+		case h.Optimizations != nil:
+			count = len(h.Optimizations.Items())
+		case h.CustomOptimizations != nil:
+			count = len(h.CustomOptimizations.Items())
+		default:
+			h.t.Fatalf("the results of a non-interactive harness are sent to its NonInteractiveView")
+		}
+		if count >= n {
+			return
+		}
+		if time.Now().After(deadline) {
+			h.t.Fatalf("got %d items within %s, expected %d", count, Timeout, n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
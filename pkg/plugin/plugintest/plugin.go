@@ -0,0 +1,186 @@
+// Package plugintest runs the plugin manager against an in-process fake plugin, so the way the CLI handles
+// plugin messages can be tested end to end without building and starting a plugin.
+package plugintest
+
+import (
+	"context"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Plugin is a fake plugin built on the plugin SDK, it answers the commands it's started with by sending the
+// messages scripted for them
+type Plugin struct {
+	Config *golang.RegisterConfig
+	// Scripts are the messages sent when a command is started, by command name
+	Scripts map[string][]*golang.PluginMessage
+	// OnReEvaluate returns the messages sent when an item is re-evaluated, e.g. the item with new recommendations
+	OnReEvaluate func(evaluate *golang.ReEvaluate) []*golang.PluginMessage
+
+	stream *sdk.StreamController
+
+	lock        sync.Mutex
+	starts      []*golang.StartProcess
+	reEvaluates []*golang.ReEvaluate
+	received    chan struct{}
+}
+
+// NewPlugin returns a fake plugin named kaytu-io/plugin-<name> providing the given commands
+func NewPlugin(name string, commands ...string) *Plugin {
+	p := &Plugin{
+		Config: &golang.RegisterConfig{
+			Name:     "kaytu-io/plugin-" + name,
+			Version:  "0.0.1",
+			Provider: name,
+		},
+		Scripts:  map[string][]*golang.PluginMessage{},
+		received: make(chan struct{}, 1),
+	}
+	for _, cmd := range commands {
+		p.Config.Commands = append(p.Config.Commands, &golang.Command{Name: cmd, Description: cmd})
+	}
+	return p
+}
+
+// Script sets the messages sent when the command is started
+func (p *Plugin) Script(command string, messages ...*golang.PluginMessage) *Plugin {
+	p.Scripts[command] = messages
+	return p
+}
+
+func (p *Plugin) SetStream(ctx context.Context, stream *sdk.StreamController) {
+	p.stream = stream
+}
+
+// GetConfig returns the fields of Config, the protocol version and capabilities are set by the SDK
+func (p *Plugin) GetConfig(ctx context.Context) golang.RegisterConfig {
+	return golang.RegisterConfig{
+		Name:            p.Config.Name,
+		Version:         p.Config.Version,
+		Provider:        p.Config.Provider,
+		Commands:        p.Config.Commands,
+		MinKaytuVersion: p.Config.MinKaytuVersion,
+		OverviewChart:   p.Config.OverviewChart,
+		DevicesChart:    p.Config.DevicesChart,
+		RootCommands:    p.Config.RootCommands,
+	}
+}
+
+func (p *Plugin) StartProcess(ctx context.Context, cmd string, flags map[string]string, kaytuAccessToken string,
+	preferences []*golang.PreferenceItem, jobQueue *sdk.JobQueue) error {
+	p.lock.Lock()
+	p.starts = append(p.starts, &golang.StartProcess{
+		Command:            cmd,
+		Flags:              flags,
+		KaytuAccessToken:   kaytuAccessToken,
+		DefaultPreferences: preferences,
+	})
+	p.lock.Unlock()
+
+	for _, msg := range p.Scripts[cmd] {
+		err := p.stream.Send(msg)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Plugin) ReEvaluate(ctx context.Context, evaluate *golang.ReEvaluate) {
+	p.lock.Lock()
+	p.reEvaluates = append(p.reEvaluates, evaluate)
+	p.lock.Unlock()
+	select {
+	case p.received <- struct{}{}:
+	default:
+	}
+
+	if p.OnReEvaluate == nil {
+		return
+	}
+	for _, msg := range p.OnReEvaluate(evaluate) {
+		p.stream.Send(msg)
+	}
+}
+
+// Attach returns a function connecting the plugin to a manager, e.g. as the AttachPlugin option of a runner run.
+// The plugin runs in-process until the test ends.
+func (p *Plugin) Attach(t testing.TB) func(addr, token, session string) {
+	return func(addr, token, session string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		exited := make(chan error, 1)
+		go func() {
+			exited <- sdk.New(p, 1).Run(ctx, addr, token, session)
+		}()
+		t.Cleanup(func() {
+			cancel()
+			<-exited
+		})
+	}
+}
+
+// Starts returns the StartProcess messages the plugin received
+func (p *Plugin) Starts() []*golang.StartProcess {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return append([]*golang.StartProcess(nil), p.starts...)
+}
+
+// ReEvaluates returns the ReEvaluate messages the plugin received
+func (p *Plugin) ReEvaluates() []*golang.ReEvaluate {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return append([]*golang.ReEvaluate(nil), p.reEvaluates...)
+}
+
+// WaitForReEvaluates waits for the plugin to have received n ReEvaluate messages and returns them, the test
+// fails if they're not received within the timeout
+func (p *Plugin) WaitForReEvaluates(t testing.TB, n int, timeout time.Duration) []*golang.ReEvaluate {
+	t.Helper()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		if reEvaluates := p.ReEvaluates(); len(reEvaluates) >= n {
+			return reEvaluates
+		}
+		select {
+		case <-p.received:
+		case <-timer.C:
+			t.Fatalf("plugin received %d re-evaluations within %s, expected %d", len(p.ReEvaluates()), timeout, n)
+			return nil
+		}
+	}
+}
+
+// Item is the message sending an optimization item
+func Item(item *golang.OptimizationItem) *golang.PluginMessage {
+	return &golang.PluginMessage{PluginMessage: &golang.PluginMessage_Oi{Oi: item}}
+}
+
+// ChartItem is the message sending an optimization item of a plugin with custom charts
+func ChartItem(item *golang.ChartOptimizationItem) *golang.PluginMessage {
+	return &golang.PluginMessage{PluginMessage: &golang.PluginMessage_Coi{Coi: item}}
+}
+
+// Job is the message reporting the state of a job
+func Job(job *golang.JobResult) *golang.PluginMessage {
+	return &golang.PluginMessage{PluginMessage: &golang.PluginMessage_Job{Job: job}}
+}
+
+// Summary is the message sending the results summary shown with custom charts
+func Summary(message string) *golang.PluginMessage {
+	return &golang.PluginMessage{PluginMessage: &golang.PluginMessage_Summary{Summary: &golang.ResultSummary{Message: message}}}
+}
+
+// Error is the message reporting an error of the plugin, sdk errors keep their code
+func Error(err error) *golang.PluginMessage {
+	return &golang.PluginMessage{PluginMessage: &golang.PluginMessage_Err{Err: sdk.ErrorProto(err)}}
+}
+
+// Ready is the message telling all the results were sent
+func Ready() *golang.PluginMessage {
+	return &golang.PluginMessage{PluginMessage: &golang.PluginMessage_Ready{Ready: &golang.ResultsReady{Ready: true}}}
+}
@@ -0,0 +1,211 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rogpeppe/go-internal/semver"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const policyFileEnv = "KAYTU_POLICY_FILE"
+
+// Policy restricts the plugins that can be installed and run, it's maintained by platform teams and read from
+// ~/.kaytu/policy.yaml or the file set in KAYTU_POLICY_FILE:
+//
+//	plugins:
+//	  - name: aws
+//	    versions: ">=0.9.0 <1.0.0"
+//	    blocked: ["0.9.2"]
+//
+// Plugins listed in the policy do not need to be approved upstream, other plugins are rejected even with --unsafe.
+type Policy struct {
+	Plugins []PluginPolicy `yaml:"plugins"`
+
+	path string
+}
+
+type PluginPolicy struct {
+	Name string `yaml:"name"`
+	// Versions is a semver range, comparators are space separated and alternatives separated by ||,
+	// e.g. ">=0.9.0 <1.0.0 || ^1.2.0". Any version is allowed if empty.
+	Versions string   `yaml:"versions,omitempty"`
+	Blocked  []string `yaml:"blocked,omitempty"`
+}
+
+// LoadPolicy reads the organization policy, a nil policy is returned when none is configured
+func LoadPolicy() (*Policy, error) {
+	path := os.Getenv(policyFileEnv)
+	explicit := path != ""
+	if !explicit {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, ".kaytu", "policy.yaml")
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && !explicit {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read policy: %v", err)
+	}
+
+	policy := Policy{path: path}
+	err = yaml.Unmarshal(content, &policy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy %s: %v", path, err)
+	}
+	for i, plg := range policy.Plugins {
+		if plg.Name == "" {
+			return nil, fmt.Errorf("invalid policy %s: plugin name is required", path)
+		}
+		policy.Plugins[i].Name = normalizePluginAddr(plg.Name)
+		if plg.Versions != "" {
+			_, err = matchesRange("v0.0.0", plg.Versions)
+			if err != nil {
+				return nil, fmt.Errorf("invalid policy %s: %v", path, err)
+			}
+		}
+	}
+	return &policy, nil
+}
+
+// Allows reports whether the plugin is listed in the policy, a nil policy allows every plugin
+func (p *Policy) Allows(name string) bool {
+	return p == nil || p.find(name) != nil
+}
+
+// Check returns an error if the given version of the plugin is not allowed by the policy
+func (p *Policy) Check(name, version string) error {
+	if p == nil {
+		return nil
+	}
+
+	plg := p.find(name)
+	if plg == nil {
+		return fmt.Errorf("plugin %s is not allowed by the policy in %s", name, p.path)
+	}
+
+	if version == "" {
+		return nil
+	}
+	for _, blocked := range plg.Blocked {
+		if canonicalVersion(blocked) == canonicalVersion(version) {
+			return fmt.Errorf("version %s of plugin %s is blocked by the policy in %s", version, name, p.path)
+		}
+	}
+	if plg.Versions != "" {
+		ok, err := matchesRange(canonicalVersion(version), plg.Versions)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("version %s of plugin %s does not satisfy %q required by the policy in %s", version, name, plg.Versions, p.path)
+		}
+	}
+	return nil
+}
+
+func (p *Policy) find(name string) *PluginPolicy {
+	name = normalizePluginAddr(name)
+	for i, plg := range p.Plugins {
+		if plg.Name == name {
+			return &p.Plugins[i]
+		}
+	}
+	return nil
+}
+
+// matchesRange checks a canonical version against a semver range. Supported comparators are =, !=, >, >=, <, <=,
+// ^ (same major, or same minor for 0.x versions) and ~ (same minor).
+func matchesRange(version, constraint string) (bool, error) {
+	if !semver.IsValid(version) {
+		return false, fmt.Errorf("invalid version %s", version)
+	}
+
+	// every alternative is parsed, so invalid ranges are rejected whatever the version
+	matched := false
+	for _, alternative := range strings.Split(constraint, "||") {
+		matches := true
+		fields := strings.Fields(alternative)
+		if len(fields) == 0 {
+			return false, fmt.Errorf("invalid version range %q", constraint)
+		}
+		for i := 0; i < len(fields); i++ {
+			comparator := fields[i]
+			// allow a space between the operator and the version, e.g. ">= 1.0.0"
+			if strings.Trim(comparator, "=!<>^~") == "" && i+1 < len(fields) {
+				i++
+				comparator += fields[i]
+			}
+			ok, err := matchesComparator(version, comparator)
+			if err != nil {
+				return false, fmt.Errorf("invalid version range %q: %v", constraint, err)
+			}
+			if !ok {
+				matches = false
+			}
+		}
+		if matches {
+			matched = true
+		}
+	}
+	return matched, nil
+}
+
+func matchesComparator(version, comparator string) (bool, error) {
+	op := comparator[:len(comparator)-len(strings.TrimLeft(comparator, "=!<>^~"))]
+	target := canonicalVersion(strings.TrimPrefix(comparator, op))
+	if !semver.IsValid(target) {
+		return false, fmt.Errorf("invalid version %s", strings.TrimPrefix(comparator, op))
+	}
+
+	cmp := semver.Compare(version, target)
+	switch op {
+	case "", "=":
+		return cmp == 0, nil
+	case "!=":
+		return cmp != 0, nil
+	case ">":
+		return cmp > 0, nil
+	case ">=":
+		return cmp >= 0, nil
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case "^", "~":
+		major, minor, err := majorMinor(target)
+		if err != nil {
+			return false, err
+		}
+		upper := fmt.Sprintf("v%d.0.0", major+1)
+		if op == "~" || major == 0 {
+			upper = fmt.Sprintf("v%d.%d.0", major, minor+1)
+		}
+		return cmp >= 0 && semver.Compare(version, upper) < 0, nil
+	}
+	return false, errors.New("unknown operator " + op)
+}
+
+func majorMinor(v string) (int, int, error) {
+	parts := strings.SplitN(strings.TrimPrefix(semver.MajorMinor(v), "v"), ".", 2)
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	minor := 0
+	if len(parts) > 1 {
+		minor, err = strconv.Atoi(parts[1])
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return major, minor, nil
+}
@@ -0,0 +1,115 @@
+package plugin
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMatchesRange(t *testing.T) {
+	tests := []struct {
+		version    string
+		constraint string
+		matches    bool
+	}{
+		{"v1.2.3", "1.2.3", true},
+		{"v1.2.3", "=1.2.4", false},
+		{"v1.2.3", ">=1.0.0 <2.0.0", true},
+		{"v2.0.0", ">=1.0.0 <2.0.0", false},
+		{"v1.2.3", ">= 1.0.0 < 2.0.0", true},
+		{"v2.0.0", ">= 1.0.0 < 2.0.0", false},
+		{"v1.2.3", "!=1.2.3", false},
+		{"v1.2.4", "!=1.2.3", true},
+		{"v1.9.0", "^1.2.0", true},
+		{"v2.0.0", "^1.2.0", false},
+		{"v0.2.5", "^0.2.1", true},
+		{"v0.3.0", "^0.2.1", false},
+		{"v0.2.0", "^0.2.1", false},
+		{"v0.2.9", "~0.2.1", true},
+		{"v0.3.0", "~0.2.1", false},
+		{"v1.3.0", "~1.2.0", false},
+		{"v0.9.5", ">=0.9.0 <1.0.0 || ^1.2.0", true},
+		{"v1.5.0", ">=0.9.0 <1.0.0 || ^1.2.0", true},
+		{"v1.1.0", ">=0.9.0 <1.0.0 || ^1.2.0", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.constraint, func(t *testing.T) {
+			matches, err := matchesRange(tt.version, tt.constraint)
+			require.NoError(t, err)
+			assert.Equal(t, tt.matches, matches)
+		})
+	}
+}
+
+func TestMatchesRangeInvalid(t *testing.T) {
+	for _, constraint := range []string{"", ">=1.0.0 ||", "^1.0.0 || >=abc", ">=abc", "=>1.0.0", "^"} {
+		t.Run(constraint, func(t *testing.T) {
+			_, err := matchesRange("v1.0.0", constraint)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPolicyCheck(t *testing.T) {
+	policy := &Policy{
+		Plugins: []PluginPolicy{
+			{Name: normalizePluginAddr("aws"), Versions: ">=0.9.0 <1.0.0", Blocked: []string{"0.9.2", "v0.9.4"}},
+		},
+		path: "policy.yaml",
+	}
+	tests := []struct {
+		name    string
+		version string
+		allowed bool
+	}{
+		{"aws", "0.9.1", true},
+		{"aws", "v0.9.1", true},
+		{"aws", "0.9.2", false},
+		{"aws", "v0.9.2", false},
+		{"aws", "0.9.4", false},
+		{"aws", "v0.9.4", false},
+		{"aws", "1.0.0", false},
+		{"aws", "", true},
+		{"kubernetes", "0.9.1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" "+tt.version, func(t *testing.T) {
+			err := policy.Check(tt.name, tt.version)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		valid   bool
+	}{
+		{"valid", "plugins:\n  - name: aws\n    versions: \">=0.9.0 <1.0.0 || ^1.2.0\"\n", true},
+		{"invalid range", "plugins:\n  - name: aws\n    versions: \">=abc\"\n", false},
+		{"missing name", "plugins:\n  - versions: \">=0.9.0\"\n", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "policy.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0600))
+			t.Setenv(policyFileEnv, path)
+
+			policy, err := LoadPolicy()
+			if !tt.valid {
+				assert.ErrorContains(t, err, "invalid policy")
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, policy.Allows("aws"))
+			assert.False(t, policy.Allows("kubernetes"))
+		})
+	}
+}
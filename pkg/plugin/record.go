@@ -0,0 +1,214 @@
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/version"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// recordingVersion is the version of the recording format, recordings of a newer version are not replayed
+const recordingVersion = 1
+
+// RecordingHeader describes the run a recording was made of, it's the first line of the recording
+type RecordingHeader struct {
+	Version      int       `json:"version"`
+	KaytuVersion string    `json:"kaytuVersion"`
+	Plugin       string    `json:"plugin"`
+	Command      string    `json:"command"`
+	RootCommand  bool      `json:"rootCommand,omitempty"`
+	Output       string    `json:"output"`
+	AgentMode    bool      `json:"agentMode,omitempty"`
+	RecordedAt   time.Time `json:"recordedAt"`
+}
+
+// recordingEntry is a line of a recording, the header or a message exchanged with the plugin in protobuf json
+type recordingEntry struct {
+	Time   time.Time        `json:"time"`
+	Header *RecordingHeader `json:"header,omitempty"`
+	Plugin json.RawMessage  `json:"plugin,omitempty"`
+	Server json.RawMessage  `json:"server,omitempty"`
+}
+
+// Recorder writes the messages exchanged with a plugin to a file, one json line each with the time it was sent
+// or received, so the run can be replayed with Replay. The kaytu access token sent to the plugin is left out.
+type Recorder struct {
+	lock sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+	err  error
+	// closed drops the messages exchanged after the recording was closed, e.g. while the plugin exits
+	closed bool
+}
+
+// NewRecorder creates the recording file at path and writes the header to it
+func NewRecorder(path string, header RecordingHeader) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	header.Version = recordingVersion
+	header.KaytuVersion = version.VERSION
+	header.RecordedAt = time.Now()
+	r := &Recorder{file: f, enc: json.NewEncoder(f)}
+	err = r.enc.Encode(recordingEntry{Time: header.RecordedAt, Header: &header})
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// SetRecorder records the messages exchanged with the plugins registering with the manager from now on
+func (m *Manager) SetRecorder(recorder *Recorder) {
+	m.pluginsLock.Lock()
+	defer m.pluginsLock.Unlock()
+	m.recorder = recorder
+}
+
+// Close closes the recording file, it returns the first error writing it
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed {
+		return r.err
+	}
+	r.closed = true
+	err := r.file.Close()
+	if r.err != nil {
+		return r.err
+	}
+	return err
+}
+
+// write adds a message to the recording. A failing recording doesn't fail the run, the error is returned by Close.
+func (r *Recorder) write(pluginMsg *golang.PluginMessage, serverMsg *golang.ServerMessage) {
+	entry := recordingEntry{Time: time.Now()}
+	var err error
+	if pluginMsg != nil {
+		entry.Plugin, err = protojson.Marshal(pluginMsg)
+	} else {
+		if start := serverMsg.GetStart(); start != nil && start.KaytuAccessToken != "" {
+			start = proto.Clone(start).(*golang.StartProcess)
+			start.KaytuAccessToken = ""
+			serverMsg = &golang.ServerMessage{ServerMessage: &golang.ServerMessage_Start{Start: start}}
+		}
+		entry.Server, err = protojson.Marshal(serverMsg)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed || r.err != nil {
+		return
+	}
+	if err == nil {
+		err = r.enc.Encode(entry)
+	}
+	if err != nil {
+		r.err = fmt.Errorf("failed to record plugin message: %v", err)
+	}
+}
+
+// recordingStream is the stream of a plugin registering with a manager recording its messages
+type recordingStream struct {
+	golang.Plugin_RegisterServer
+	recorder *Recorder
+}
+
+func (s *recordingStream) Send(msg *golang.ServerMessage) error {
+	s.recorder.write(nil, msg)
+	return s.Plugin_RegisterServer.Send(msg)
+}
+
+func (s *recordingStream) Recv() (*golang.PluginMessage, error) {
+	msg, err := s.Plugin_RegisterServer.Recv()
+	if err == nil {
+		s.recorder.write(msg, nil)
+	}
+	return msg, err
+}
+
+// RecordedMessage is a message of a recording, either sent by the plugin or by the CLI
+type RecordedMessage struct {
+	Time   time.Time
+	Plugin *golang.PluginMessage
+	Server *golang.ServerMessage
+}
+
+// Recording is a recording read with LoadRecording
+type Recording struct {
+	Header   RecordingHeader
+	Messages []RecordedMessage
+}
+
+// LoadRecording reads the recording at path
+func LoadRecording(path string) (*Recording, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rec := &Recording{}
+	dec := json.NewDecoder(f)
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	for line := 1; ; line++ {
+		var entry recordingEntry
+		err = dec.Decode(&entry)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid recording %s: entry %d: %v", path, line, err)
+		}
+
+		switch {
+		case line == 1:
+			if entry.Header == nil {
+				return nil, fmt.Errorf("invalid recording %s: the header is missing", path)
+			}
+			if entry.Header.Version > recordingVersion {
+				return nil, fmt.Errorf("recording %s was made by kaytu %s, please update your Kaytu CLI to replay it",
+					path, entry.Header.KaytuVersion)
+			}
+			rec.Header = *entry.Header
+		case entry.Plugin != nil:
+			msg := &golang.PluginMessage{}
+			err = unmarshal.Unmarshal(entry.Plugin, msg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid recording %s: entry %d: %v", path, line, err)
+			}
+			rec.Messages = append(rec.Messages, RecordedMessage{Time: entry.Time, Plugin: msg})
+		case entry.Server != nil:
+			msg := &golang.ServerMessage{}
+			err = unmarshal.Unmarshal(entry.Server, msg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid recording %s: entry %d: %v", path, line, err)
+			}
+			rec.Messages = append(rec.Messages, RecordedMessage{Time: entry.Time, Server: msg})
+		}
+	}
+
+	if rec.Start() == nil {
+		return nil, fmt.Errorf("recording %s holds no command sent to the plugin", path)
+	}
+	return rec, nil
+}
+
+// Start is the first StartProcess sent to the plugin
+func (r *Recording) Start() *golang.StartProcess {
+	for _, msg := range r.Messages {
+		if msg.Server.GetStart() != nil {
+			return msg.Server.GetStart()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package plugin_test
+
+import (
+	"context"
+	"github.com/kaytu-io/kaytu/controller"
+	"github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/kaytu-io/kaytu/pkg/plugin/plugintest"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.kaytu")
+	plg := plugintest.NewPlugin("fake", "ec2-instance").Script("ec2-instance",
+		plugintest.Job(&golang.JobResult{Id: "list", Description: "Listing instances"}),
+		plugintest.Item(&golang.OptimizationItem{Id: "i-0123", Name: "web", ResourceType: "t3.large", Region: "us-east-1"}),
+		plugintest.Job(&golang.JobResult{Id: "list", Description: "Listing instances", Done: true}),
+		plugintest.Ready(),
+	)
+	start := &golang.StartProcess{
+		Command:          "ec2-instance",
+		Flags:            map[string]string{"region": "us-east-1", "output": "json"},
+		KaytuAccessToken: "secret-token",
+	}
+
+	recorder, err := plugin.NewRecorder(path, plugin.RecordingHeader{Plugin: plg.Config.Name, Command: "ec2-instance", Output: "json"})
+	require.NoError(t, err)
+	live := plugin.New()
+	live.SetNonInteractiveView(false)
+	live.SetRecorder(recorder)
+	require.NoError(t, live.StartServer())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	exited := make(chan error, 1)
+	go func() {
+		exited <- sdk.New(plg, 1).Run(ctx, live.ServerAddr(), live.AuthToken(), "")
+	}()
+	_, err = live.WaitForPlugin(ctx, plg.Config.Name)
+	require.NoError(t, err)
+	live.NonInteractiveView.SetOptimizations(controller.NewOptimizations[golang.OptimizationItem](), nil, nil, nil)
+	require.NoError(t, live.SendStart(plg.Config.Name, start))
+	require.NoError(t, live.NonInteractiveView.WaitForResults())
+	want, err := live.NonInteractiveView.RenderResults("json")
+	require.NoError(t, err)
+
+	live.Shutdown("test finished", time.Second)
+	cancel()
+	live.StopServer()
+	<-exited
+	require.NoError(t, recorder.Close())
+
+	rec, err := plugin.LoadRecording(path)
+	require.NoError(t, err)
+	assert.Equal(t, plg.Config.Name, rec.Header.Plugin)
+	assert.Equal(t, "ec2-instance", rec.Header.Command)
+	assert.Equal(t, "us-east-1", rec.Start().GetFlags()["region"])
+	assert.Empty(t, rec.Start().GetKaytuAccessToken())
+
+	replay := plugin.New()
+	replay.SetNonInteractiveView(false)
+	running, err := replay.Replay(context.Background(), rec, 0)
+	require.NoError(t, err)
+	assert.Equal(t, plg.Config.Name, running.Plugin.Config.Name)
+	replay.NonInteractiveView.SetOptimizations(controller.NewOptimizations[golang.OptimizationItem](), nil, nil, nil)
+	require.NoError(t, replay.SendStart(running.Plugin.Config.Name, rec.Start()))
+	require.NoError(t, replay.NonInteractiveView.WaitForResults())
+	got, err := replay.NonInteractiveView.RenderResults("json")
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,189 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	githubAPI "github.com/google/go-github/v62/github"
+	"golang.org/x/oauth2"
+	"io"
+	"net/http"
+	"runtime"
+	"strings"
+)
+
+// Github serves plugins from the releases of github.com/<owner>/<repository>
+type Github struct {
+	api       *githubAPI.Client
+	publicKey string
+}
+
+func NewGithub(token, publicKey string) *Github {
+	var tc *http.Client
+	if token != "" {
+		ts := oauth2.StaticTokenSource(
+			&oauth2.Token{AccessToken: token},
+		)
+		tc = oauth2.NewClient(context.Background(), ts)
+	}
+	return &Github{
+		api:       githubAPI.NewClient(tc),
+		publicKey: publicKey,
+	}
+}
+
+func (g *Github) Name() string {
+	return TypeGithub
+}
+
+func (g *Github) Release(ctx context.Context, addr, version string) (*Release, error) {
+	owner, repository, _ := strings.Cut(addr, "/")
+
+	var release *githubAPI.RepositoryRelease
+	var resp *githubAPI.Response
+	var err error
+	if version != "" {
+		release, resp, err = g.api.Repositories.GetReleaseByTag(ctx, owner, repository, canonicalVersion(version))
+	} else {
+		release, resp, err = g.api.Repositories.GetLatestRelease(ctx, owner, repository)
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	for _, asset := range release.Assets {
+		if asset.ID == nil || asset.Name == nil {
+			continue
+		}
+		assetVersion, ok := MatchAsset(*asset.Name)
+		if !ok {
+			continue
+		}
+
+		r := &Release{
+			Version:   assetVersion,
+			AssetName: *asset.Name,
+			Size:      int64(asset.GetSize()),
+			location:  addr,
+			id:        *asset.ID,
+		}
+		checksums, err := g.fetchChecksums(ctx, owner, repository, release.Assets)
+		if err == nil {
+			r.SHA256, err = LookupChecksum(checksums, r.AssetName)
+		}
+		r.VerifyErr = err
+		return r, nil
+	}
+	return nil, fmt.Errorf("no release asset found for %s/%s", runtime.GOOS, runtime.GOARCH)
+}
+
+func (g *Github) Download(ctx context.Context, release *Release) (io.ReadCloser, error) {
+	owner, repository, _ := strings.Cut(release.location, "/")
+	return g.downloadAsset(ctx, owner, repository, release.id)
+}
+
+func (g *Github) IsApproved(ctx context.Context, addr string) (bool, error) {
+	if addr == "kaytu-io/plugin-aws" {
+		return true, nil
+	}
+	fileContent, _, resp, err := g.api.Repositories.GetContents(ctx, "kaytu-io", "kaytu", "approved_plugins", nil)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.StatusCode != 200 {
+		return false, fmt.Errorf("invalid status code: %d", resp.StatusCode)
+	}
+
+	content, err := fileContent.GetContent()
+	if err != nil {
+		return false, err
+	}
+	plugins := strings.Split(content, "\n")
+	for _, plugin := range plugins {
+		if plugin == addr {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (g *Github) downloadAsset(ctx context.Context, owner, repository string, id int64) (io.ReadCloser, error) {
+	rc, url, err := g.api.Repositories.DownloadReleaseAsset(ctx, owner, repository, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(url) > 0 {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("invalid status code: %d", resp.StatusCode)
+		}
+
+		rc = resp.Body
+	}
+	return rc, nil
+}
+
+// fetchChecksums downloads the checksums file of the release and, when a public key is configured, verifies its
+// detached signature (<checksums file>.sig) before returning the file content
+func (g *Github) fetchChecksums(ctx context.Context, owner, repository string, assets []*githubAPI.ReleaseAsset) ([]byte, error) {
+	var checksumsAsset, signatureAsset *githubAPI.ReleaseAsset
+	for _, asset := range assets {
+		if asset.ID == nil || asset.Name == nil {
+			continue
+		}
+		if IsChecksumsFile(*asset.Name) {
+			checksumsAsset = asset
+		}
+	}
+	if checksumsAsset == nil {
+		return nil, errors.New("release does not contain a checksums file")
+	}
+	for _, asset := range assets {
+		if asset.ID != nil && asset.Name != nil && *asset.Name == *checksumsAsset.Name+".sig" {
+			signatureAsset = asset
+		}
+	}
+
+	checksums, err := g.readAsset(ctx, owner, repository, checksumsAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.publicKey == "" {
+		return checksums, nil
+	}
+	if signatureAsset == nil {
+		return nil, errors.New("release does not contain a signature for the checksums file")
+	}
+	signature, err := g.readAsset(ctx, owner, repository, signatureAsset)
+	if err != nil {
+		return nil, err
+	}
+	err = VerifySignature(g.publicKey, checksums, signature)
+	if err != nil {
+		return nil, err
+	}
+	return checksums, nil
+}
+
+func (g *Github) readAsset(ctx context.Context, owner, repository string, asset *githubAPI.ReleaseAsset) ([]byte, error) {
+	rc, err := g.downloadAsset(ctx, owner, repository, *asset.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
@@ -0,0 +1,171 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/rogpeppe/go-internal/semver"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"runtime"
+)
+
+// HTTP serves plugins listed in a JSON index, e.g. an internal mirror:
+//
+//	{"plugins": [{"name": "kaytu-io/plugin-aws", "version": "0.9.3", "os": "linux", "arch": "amd64",
+//	  "url": "plugin-aws/plugin_0.9.3_linux_amd64", "sha256": "...", "size": 1024}]}
+//
+// Relative urls are resolved against the index url. When a public key is configured the index must be signed,
+// the signature is fetched from <index url>.sig.
+type HTTP struct {
+	indexURL  string
+	token     string
+	publicKey string
+	client    *http.Client
+}
+
+type httpIndex struct {
+	Plugins []httpIndexEntry `json:"plugins"`
+}
+
+type httpIndexEntry struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	OS      string `json:"os"`
+	Arch    string `json:"arch"`
+	URL     string `json:"url"`
+	SHA256  string `json:"sha256"`
+	Size    int64  `json:"size,omitempty"`
+}
+
+func NewHTTP(indexURL, token, publicKey string) *HTTP {
+	return &HTTP{
+		indexURL:  indexURL,
+		token:     token,
+		publicKey: publicKey,
+		client:    http.DefaultClient,
+	}
+}
+
+func (h *HTTP) Name() string {
+	return h.indexURL
+}
+
+func (h *HTTP) Release(ctx context.Context, addr, version string) (*Release, error) {
+	index, err := h.fetchIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *httpIndexEntry
+	for i, entry := range index.Plugins {
+		if entry.Name != addr || entry.OS != runtime.GOOS || entry.Arch != runtime.GOARCH {
+			continue
+		}
+		if version != "" {
+			if canonicalVersion(entry.Version) == canonicalVersion(version) {
+				found = &index.Plugins[i]
+				break
+			}
+			continue
+		}
+		if found == nil || semver.Compare(canonicalVersion(entry.Version), canonicalVersion(found.Version)) > 0 {
+			found = &index.Plugins[i]
+		}
+	}
+	if found == nil {
+		return nil, ErrNotFound
+	}
+
+	location, err := h.resolve(found.URL)
+	if err != nil {
+		return nil, err
+	}
+	release := &Release{
+		Version:   found.Version,
+		AssetName: path.Base(location),
+		Size:      found.Size,
+		SHA256:    found.SHA256,
+		location:  location,
+	}
+	if release.SHA256 == "" {
+		release.VerifyErr = fmt.Errorf("index does not contain a checksum for %s", addr)
+	}
+	return release, nil
+}
+
+func (h *HTTP) Download(ctx context.Context, release *Release) (io.ReadCloser, error) {
+	return h.get(ctx, release.location)
+}
+
+// IsApproved approves every plugin listed in the index, the index itself is curated by whoever hosts it
+func (h *HTTP) IsApproved(ctx context.Context, addr string) (bool, error) {
+	return true, nil
+}
+
+func (h *HTTP) fetchIndex(ctx context.Context) (*httpIndex, error) {
+	content, err := h.read(ctx, h.indexURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.publicKey != "" {
+		signature, err := h.read(ctx, h.indexURL+".sig")
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch index signature: %v", err)
+		}
+		err = VerifySignature(h.publicKey, content, signature)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var index httpIndex
+	err = json.Unmarshal(content, &index)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry index: %v", err)
+	}
+	return &index, nil
+}
+
+func (h *HTTP) resolve(ref string) (string, error) {
+	base, err := url.Parse(h.indexURL)
+	if err != nil {
+		return "", err
+	}
+	u, err := base.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (h *HTTP) read(ctx context.Context, u string) ([]byte, error) {
+	rc, err := h.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+func (h *HTTP) get(ctx context.Context, u string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if h.token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.token)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("invalid status code: %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
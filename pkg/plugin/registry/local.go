@@ -0,0 +1,132 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rogpeppe/go-internal/semver"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Local serves plugins from a directory, e.g. a shared drive on air-gapped machines. Each release is a directory
+// holding the release assets as published on github: <path>/<owner>_<repository>/<version>/
+type Local struct {
+	path      string
+	publicKey string
+}
+
+func NewLocal(path, publicKey string) *Local {
+	return &Local{
+		path:      path,
+		publicKey: publicKey,
+	}
+}
+
+func (l *Local) Name() string {
+	return l.path
+}
+
+func (l *Local) Release(ctx context.Context, addr, version string) (*Release, error) {
+	pluginDir := filepath.Join(l.path, strings.ReplaceAll(addr, "/", "_"))
+	entries, err := os.ReadDir(pluginDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	releaseDir := ""
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if version != "" {
+			if canonicalVersion(entry.Name()) == canonicalVersion(version) {
+				releaseDir = entry.Name()
+				break
+			}
+			continue
+		}
+		if releaseDir == "" || semver.Compare(canonicalVersion(entry.Name()), canonicalVersion(releaseDir)) > 0 {
+			releaseDir = entry.Name()
+		}
+	}
+	if releaseDir == "" {
+		return nil, ErrNotFound
+	}
+	releaseDir = filepath.Join(pluginDir, releaseDir)
+
+	assets, err := os.ReadDir(releaseDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, asset := range assets {
+		if asset.IsDir() {
+			continue
+		}
+		assetVersion, ok := MatchAsset(asset.Name())
+		if !ok {
+			continue
+		}
+		info, err := asset.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		release := &Release{
+			Version:   assetVersion,
+			AssetName: asset.Name(),
+			Size:      info.Size(),
+			location:  filepath.Join(releaseDir, asset.Name()),
+		}
+		checksums, err := l.readChecksums(releaseDir, assets)
+		if err == nil {
+			release.SHA256, err = LookupChecksum(checksums, release.AssetName)
+		}
+		release.VerifyErr = err
+		return release, nil
+	}
+	return nil, fmt.Errorf("no release asset found for %s/%s in %s", runtime.GOOS, runtime.GOARCH, releaseDir)
+}
+
+func (l *Local) Download(ctx context.Context, release *Release) (io.ReadCloser, error) {
+	return os.Open(release.location)
+}
+
+// IsApproved approves every plugin in the directory, it's maintained by the administrators of the machine
+func (l *Local) IsApproved(ctx context.Context, addr string) (bool, error) {
+	return true, nil
+}
+
+func (l *Local) readChecksums(dir string, entries []os.DirEntry) ([]byte, error) {
+	checksumsFile := ""
+	for _, entry := range entries {
+		if !entry.IsDir() && IsChecksumsFile(entry.Name()) {
+			checksumsFile = filepath.Join(dir, entry.Name())
+		}
+	}
+	if checksumsFile == "" {
+		return nil, errors.New("release does not contain a checksums file")
+	}
+
+	checksums, err := os.ReadFile(checksumsFile)
+	if err != nil {
+		return nil, err
+	}
+	if l.publicKey != "" {
+		signature, err := os.ReadFile(checksumsFile + ".sig")
+		if err != nil {
+			return nil, errors.New("release does not contain a signature for the checksums file")
+		}
+		err = VerifySignature(l.publicKey, checksums, signature)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return checksums, nil
+}
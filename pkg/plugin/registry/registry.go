@@ -0,0 +1,125 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+const (
+	TypeGithub = "github"
+	TypeHTTP   = "http"
+	TypeLocal  = "local"
+)
+
+var (
+	ErrNotFound = errors.New("plugin not found in registry")
+
+	assetPattern = regexp.MustCompile(fmt.Sprintf("plugin_([a-z0-9\\.]+)_%s_%s(\\.exe|\\.tar\\.gz|\\.tgz|\\.zip)?$", runtime.GOOS, runtime.GOARCH))
+)
+
+// Config is a plugin registry as configured in kaytu-config.json
+type Config struct {
+	// Type is one of github, http or local
+	Type string `json:"type"`
+	// URL of the JSON index for http registries
+	URL string `json:"url,omitempty"`
+	// Path of the plugins directory for local registries
+	Path string `json:"path,omitempty"`
+	// Token is a github access token or a bearer token sent to http registries
+	Token string `json:"token,omitempty"`
+}
+
+// Release is a plugin release for the current platform
+type Release struct {
+	Version   string
+	AssetName string
+	Size      int64
+	// SHA256 is the expected hex encoded checksum of the asset, empty if the registry does not provide one
+	SHA256 string
+	// VerifyErr is set when the registry could not verify the release checksums or their signature
+	VerifyErr error
+
+	location string
+	id       int64
+}
+
+type Registry interface {
+	Name() string
+	// Release returns the given version of the plugin, or the latest one when version is empty.
+	// ErrNotFound is returned if the registry does not serve the plugin.
+	Release(ctx context.Context, addr, version string) (*Release, error)
+	Download(ctx context.Context, release *Release) (io.ReadCloser, error)
+	// IsApproved reports whether the plugin can be installed without --unsafe
+	IsApproved(ctx context.Context, addr string) (bool, error)
+}
+
+// FromConfig builds the registries in priority order, the github registry is used when none is configured.
+// token overrides the token of github registries, publicKey is used to verify checksum signatures.
+func FromConfig(configs []Config, token, publicKey string) ([]Registry, error) {
+	if len(configs) == 0 {
+		configs = []Config{{Type: TypeGithub}}
+	}
+
+	var registries []Registry
+	for _, c := range configs {
+		switch c.Type {
+		case TypeGithub, "":
+			if token != "" {
+				c.Token = token
+			}
+			registries = append(registries, NewGithub(c.Token, publicKey))
+		case TypeHTTP:
+			if c.URL == "" {
+				return nil, errors.New("http registry requires url")
+			}
+			registries = append(registries, NewHTTP(c.URL, c.Token, publicKey))
+		case TypeLocal:
+			if c.Path == "" {
+				return nil, errors.New("local registry requires path")
+			}
+			registries = append(registries, NewLocal(c.Path, publicKey))
+		default:
+			return nil, fmt.Errorf("unknown registry type: %s", c.Type)
+		}
+	}
+	return registries, nil
+}
+
+// Find returns the release of the plugin from the first registry serving it
+func Find(ctx context.Context, registries []Registry, addr, version string) (Registry, *Release, error) {
+	var errs []error
+	for _, r := range registries {
+		release, err := r.Release(ctx, addr, version)
+		if err == nil {
+			return r, release, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			errs = append(errs, fmt.Errorf("%s: %v", r.Name(), err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, nil, errors.Join(errs...)
+	}
+	return nil, nil, ErrNotFound
+}
+
+// MatchAsset reports whether name is a plugin release asset for the current platform and returns its version
+func MatchAsset(name string) (string, bool) {
+	m := assetPattern.FindStringSubmatch(name)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
+
+func canonicalVersion(v string) string {
+	if v != "" && !strings.HasPrefix(v, "v") {
+		return "v" + v
+	}
+	return v
+}
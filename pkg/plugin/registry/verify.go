@@ -0,0 +1,100 @@
+package registry
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func IsChecksumsFile(name string) bool {
+	return name == "checksums.txt" || strings.HasSuffix(name, "_checksums.txt")
+}
+
+// VerifyChecksum checks sum against the entry of assetName in a sha256sum formatted checksums file
+func VerifyChecksum(checksums []byte, assetName string, sum []byte) error {
+	expected, err := LookupChecksum(checksums, assetName)
+	if err != nil {
+		return err
+	}
+	return CompareChecksum(assetName, expected, sum)
+}
+
+// LookupChecksum returns the hex encoded entry of assetName in a sha256sum formatted checksums file
+func LookupChecksum(checksums []byte, assetName string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || strings.TrimPrefix(fields[1], "*") != assetName {
+			continue
+		}
+		return fields[0], nil
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("checksum for %s not found", assetName)
+}
+
+// CompareChecksum checks sum against the hex encoded expected checksum
+func CompareChecksum(assetName, expected string, sum []byte) error {
+	decoded, err := hex.DecodeString(expected)
+	if err != nil {
+		return fmt.Errorf("invalid checksum for %s: %v", assetName, err)
+	}
+	if !bytes.Equal(decoded, sum) {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", assetName, expected, hex.EncodeToString(sum))
+	}
+	return nil
+}
+
+// VerifySignature verifies an ed25519 detached signature of data. The public key is expected in PEM (PKIX) format
+// or as a base64 encoded raw key, the signature either raw or base64 encoded.
+func VerifySignature(publicKey string, data, signature []byte) error {
+	key, err := parsePublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+
+	if len(signature) != ed25519.SignatureSize {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+		if err != nil {
+			return fmt.Errorf("invalid signature: %v", err)
+		}
+		signature = decoded
+	}
+
+	if !ed25519.Verify(key, data, signature) {
+		return errors.New("signature verification failed")
+	}
+	return nil
+}
+
+func parsePublicKey(publicKey string) (ed25519.PublicKey, error) {
+	if block, _ := pem.Decode([]byte(publicKey)); block != nil {
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %v", err)
+		}
+		edKey, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return nil, errors.New("invalid public key: only ed25519 keys are supported")
+		}
+		return edKey, nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %v", err)
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid public key: only ed25519 keys are supported")
+	}
+	return raw, nil
+}
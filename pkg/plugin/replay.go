@@ -0,0 +1,125 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"sync"
+	"time"
+)
+
+// Replay runs a recording through Register as if its plugin was running, without starting the plugin. The
+// messages of the plugin up to its registration are replayed right away, the others once the command is sent
+// with SendStart, at the pace they were recorded at sped up by speed. A speed of 0 replays them without waiting.
+// Messages sent to the plugin, e.g. re-evaluations, are dropped.
+func (m *Manager) Replay(ctx context.Context, rec *Recording, speed float64) (*RunningPlugin, error) {
+	registers, ready := false, false
+	for _, msg := range rec.Messages {
+		registers = registers || msg.Plugin.GetConf() != nil
+		ready = ready || msg.Plugin.GetReady().GetReady()
+	}
+	if !registers {
+		return nil, errors.New("the plugin didn't register in the recording")
+	}
+
+	stream := &replayStream{ctx: ctx, messages: rec.Messages, speed: speed, started: make(chan struct{})}
+	go func() {
+		err := m.Register(stream)
+		if err == nil && !ready {
+			err = errors.New("the recording ended before the plugin sent its results")
+		}
+		if err != nil && ctx.Err() == nil {
+			m.publishPluginExit(err)
+		}
+	}()
+
+	timeout := m.registerTimeout
+	if timeout <= 0 {
+		timeout = DefaultRegisterTimeout
+	}
+	return m.waitForRegistration(ctx, rec.Header.Plugin, timeout, func(plg RunningPlugin) bool {
+		return plg.Plugin.Config.Name == rec.Header.Plugin
+	})
+}
+
+// replayStream is the stream of a recorded plugin, it receives the recorded plugin messages
+type replayStream struct {
+	ctx      context.Context
+	messages []RecordedMessage
+	speed    float64
+
+	// started is closed when the command is sent, the results are replayed from then on
+	started     chan struct{}
+	startedOnce sync.Once
+	playing     bool
+	next        int
+	last        time.Time
+}
+
+func (s *replayStream) Recv() (*golang.PluginMessage, error) {
+	for s.next < len(s.messages) {
+		msg := s.messages[s.next]
+		s.next++
+
+		if !s.playing && msg.Server.GetStart() != nil {
+			select {
+			case <-s.started:
+			case <-s.ctx.Done():
+				return nil, s.ctx.Err()
+			}
+			s.playing = true
+			s.last = msg.Time
+		}
+		// messages sent by the CLI only count for the pace of the replay
+		if msg.Plugin == nil {
+			s.last = msg.Time
+			continue
+		}
+
+		if s.playing && s.speed > 0 && msg.Time.After(s.last) {
+			timer := time.NewTimer(time.Duration(float64(msg.Time.Sub(s.last)) / s.speed))
+			select {
+			case <-timer.C:
+			case <-s.ctx.Done():
+				timer.Stop()
+				return nil, s.ctx.Err()
+			}
+		}
+		s.last = msg.Time
+		return msg.Plugin, nil
+	}
+	return nil, io.EOF
+}
+
+func (s *replayStream) Send(msg *golang.ServerMessage) error {
+	if msg.GetStart() != nil {
+		s.startedOnce.Do(func() {
+			close(s.started)
+		})
+	}
+	return nil
+}
+
+func (s *replayStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *replayStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *replayStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *replayStream) SetTrailer(metadata.MD) {}
+
+func (s *replayStream) SendMsg(any) error {
+	return nil
+}
+
+func (s *replayStream) RecvMsg(any) error {
+	return io.EOF
+}
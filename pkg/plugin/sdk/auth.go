@@ -0,0 +1,13 @@
+package sdk
+
+const (
+	// AuthTokenEnv carries the per-run secret the CLI generates for the plugins it starts
+	AuthTokenEnv = "KAYTU_PLUGIN_TOKEN"
+	// AuthTokenMetadataKey is the gRPC metadata key the secret is sent back in when registering
+	AuthTokenMetadataKey = "kaytu-plugin-token"
+
+	// SessionEnv identifies the optimize session a plugin was started for when the CLI runs several at once
+	SessionEnv = "KAYTU_PLUGIN_SESSION"
+	// SessionMetadataKey is the gRPC metadata key the session is sent back in when registering
+	SessionMetadataKey = "kaytu-plugin-session"
+)
@@ -0,0 +1,90 @@
+package sdk
+
+import (
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+)
+
+// ProtocolVersion is the version of the plugin protocol implemented by this SDK and the CLI built with it
+const ProtocolVersion = 1
+
+// LegacyCapabilities are assumed for peers that predate capability negotiation (protocol version 0)
+var LegacyCapabilities = []golang.Capability{
+	golang.Capability_CAPABILITY_CUSTOM_CHARTS,
+	golang.Capability_CAPABILITY_SUMMARY_TABLE,
+	golang.Capability_CAPABILITY_NON_INTERACTIVE_EXPORT,
+}
+
+// Capabilities is a negotiated set of capabilities
+type Capabilities map[golang.Capability]bool
+
+// Negotiate returns the capabilities supported by both sides
+func Negotiate(local []golang.Capability, remoteProtocolVersion uint32, remote []golang.Capability) Capabilities {
+	if remoteProtocolVersion == 0 {
+		remote = LegacyCapabilities
+	}
+
+	remoteSet := map[golang.Capability]bool{}
+	for _, c := range remote {
+		remoteSet[c] = true
+	}
+	result := Capabilities{}
+	for _, c := range local {
+		if remoteSet[c] {
+			result[c] = true
+		}
+	}
+	return result
+}
+
+func (c Capabilities) Has(capability golang.Capability) bool {
+	return c[capability]
+}
+
+// pluginCapabilities are the capabilities the SDK advertises for the plugin
+func pluginCapabilities(conf *golang.RegisterConfig) []golang.Capability {
+	capabilities := []golang.Capability{
+		golang.Capability_CAPABILITY_SUMMARY_TABLE,
+		golang.Capability_CAPABILITY_NON_INTERACTIVE_EXPORT,
+		golang.Capability_CAPABILITY_CANCELLATION,
+		golang.Capability_CAPABILITY_STRUCTURED_ERRORS,
+		golang.Capability_CAPABILITY_JOB_PROGRESS,
+		golang.Capability_CAPABILITY_LOGS,
+		golang.Capability_CAPABILITY_PING,
+	}
+	if conf.GetOverviewChart() != nil && conf.GetDevicesChart() != nil {
+		capabilities = append(capabilities, golang.Capability_CAPABILITY_CUSTOM_CHARTS)
+	}
+	// capabilities declared by the plugin itself are kept
+	for _, c := range conf.GetCapabilities() {
+		if !contains(capabilities, c) {
+			capabilities = append(capabilities, c)
+		}
+	}
+	return capabilities
+}
+
+// requiredCapability is the capability the server needs to handle the message, unspecified if none is needed
+func requiredCapability(msg *golang.PluginMessage) golang.Capability {
+	switch {
+	case msg.GetCoi() != nil, msg.GetUpdateChart() != nil:
+		return golang.Capability_CAPABILITY_CUSTOM_CHARTS
+	case msg.GetSummaryTable() != nil:
+		return golang.Capability_CAPABILITY_SUMMARY_TABLE
+	case msg.GetNonInteractive() != nil:
+		return golang.Capability_CAPABILITY_NON_INTERACTIVE_EXPORT
+	case msg.GetJob().GetProgress() != nil:
+		return golang.Capability_CAPABILITY_JOB_PROGRESS
+	case msg.GetLog() != nil:
+		return golang.Capability_CAPABILITY_LOGS
+	}
+	return golang.Capability_CAPABILITY_UNSPECIFIED
+}
+
+func contains(capabilities []golang.Capability, capability golang.Capability) bool {
+	for _, c := range capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
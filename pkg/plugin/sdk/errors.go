@@ -0,0 +1,93 @@
+package sdk
+
+import (
+	"errors"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+)
+
+// Error is an error the CLI can act on by its code, e.g. showing the login hint when the credentials expired.
+// Jobs failing with an Error (or an error wrapping one) report it with their result.
+type Error struct {
+	Code       golang.ErrorCode
+	Retryable  bool
+	ResourceID string
+	Details    map[string]string
+	Err        error
+}
+
+// NewError wraps err with the given code
+func NewError(code golang.ErrorCode, err error) *Error {
+	return &Error{Code: code, Err: err}
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return e.Code.String()
+	}
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// WithResource sets the id of the resource the error is about
+func (e *Error) WithResource(id string) *Error {
+	e.ResourceID = id
+	return e
+}
+
+// WithRetryable marks the error as temporary, e.g. rate limits
+func (e *Error) WithRetryable(retryable bool) *Error {
+	e.Retryable = retryable
+	return e
+}
+
+// WithDetail adds a detail shown along with the error
+func (e *Error) WithDetail(key, value string) *Error {
+	if e.Details == nil {
+		e.Details = map[string]string{}
+	}
+	e.Details[key] = value
+	return e
+}
+
+// ErrorProto converts err to its protocol message, errors without an Error in their chain have no code
+func ErrorProto(err error) *golang.Error {
+	if err == nil {
+		return nil
+	}
+
+	msg := &golang.Error{Error: err.Error()}
+	var structured *Error
+	if errors.As(err, &structured) {
+		msg.Code = structured.Code
+		msg.Retryable = structured.Retryable
+		msg.ResourceId = structured.ResourceID
+		msg.Details = structured.Details
+	}
+	return msg
+}
+
+// FromProto converts the protocol message back to an error, so it can be checked with errors.As
+func FromProto(msg *golang.Error) error {
+	if msg == nil {
+		return nil
+	}
+	return &Error{
+		Code:       msg.GetCode(),
+		Retryable:  msg.GetRetryable(),
+		ResourceID: msg.GetResourceId(),
+		Details:    msg.GetDetails(),
+		Err:        errors.New(msg.GetError()),
+	}
+}
+
+// ErrorCode returns the code of the first Error in err's chain, or ERROR_CODE_UNSPECIFIED
+func ErrorCode(err error) golang.ErrorCode {
+	var structured *Error
+	if errors.As(err, &structured) {
+		return structured.Code
+	}
+	return golang.ErrorCode_ERROR_CODE_UNSPECIFIED
+}
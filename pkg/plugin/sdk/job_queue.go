@@ -7,10 +7,14 @@ import (
 	"github.com/kaytu-io/kaytu/pkg/utils"
 	"log"
 	"runtime/debug"
+	"sync"
 	"sync/atomic"
 	"time"
 )
 
+// progressInterval limits how often the progress of a job is sent to the CLI
+const progressInterval = 500 * time.Millisecond
+
 type JobProperties struct {
 	ID          string
 	Description string
@@ -31,6 +35,16 @@ type JobQueue struct {
 	finishedCounter atomic.Uint32
 	onFinish        func(ctx context.Context)
 	retryCount      utils.ConcurrentMap[string, int]
+	progress        utils.ConcurrentMap[string, *jobProgress]
+}
+
+type jobProgress struct {
+	lock        sync.Mutex
+	description string
+	current     uint64
+	total       uint64
+	phase       string
+	lastSent    time.Time
 }
 
 func NewJobQueue(maxConcurrent int, stream *StreamController) *JobQueue {
@@ -39,6 +53,7 @@ func NewJobQueue(maxConcurrent int, stream *StreamController) *JobQueue {
 		maxConcurrent: maxConcurrent,
 		stream:        stream,
 		retryCount:    utils.NewConcurrentMap[string, int](),
+		progress:      utils.NewConcurrentMap[string, *jobProgress](),
 
 		pendingCounter:  atomic.Uint32{},
 		finishedCounter: atomic.Uint32{},
@@ -49,6 +64,7 @@ func (q *JobQueue) Push(job Job) {
 	props := job.Properties()
 	log.Printf("Pushing job %s to queue", props.ID)
 	q.pendingCounter.Add(1)
+	q.progress.Set(props.ID, &jobProgress{description: props.Description})
 
 	q.stream.Send(&golang.PluginMessage{
 		PluginMessage: &golang.PluginMessage_Job{
@@ -104,7 +120,7 @@ func (q *JobQueue) SetOnFinish(f func(ctx context.Context)) {
 func (q *JobQueue) runJob(ctx context.Context, job Job) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			err = fmt.Errorf("job paniced: %v, stack: %v", r, string(debug.Stack()))
+			err = NewError(golang.ErrorCode_ERROR_CODE_INTERNAL, fmt.Errorf("job paniced: %v, stack: %v", r, string(debug.Stack())))
 		}
 	}()
 
@@ -119,7 +135,7 @@ func (q *JobQueue) handleJob(ctx context.Context, job Job) {
 			log.Printf("Job queue handle job panic: %v, stack: %v", r, string(debug.Stack()))
 			q.stream.Send(&golang.PluginMessage{
 				PluginMessage: &golang.PluginMessage_Err{
-					Err: &golang.Error{Error: fmt.Sprintf("job %s paniced: %v", props.ID, r)},
+					Err: &golang.Error{Error: fmt.Sprintf("job %s paniced: %v", props.ID, r), Code: golang.ErrorCode_ERROR_CODE_INTERNAL},
 				},
 			})
 		}
@@ -134,6 +150,7 @@ func (q *JobQueue) handleJob(ctx context.Context, job Job) {
 	log.Printf("Running job %s", props.ID)
 	if err := q.runJob(ctx, job); err != nil {
 		jobResult.FailureMessage = err.Error()
+		jobResult.Error = ErrorProto(err)
 		if v, ok := q.retryCount.Get(props.ID); v < props.MaxRetry {
 			if !ok {
 				v2, loaded := q.retryCount.LoadOrStore(props.ID, 0)
@@ -158,6 +175,7 @@ func (q *JobQueue) handleJob(ctx context.Context, job Job) {
 		log.Printf("Finished job %s", props.ID)
 	}
 
+	q.progress.Delete(props.ID)
 	q.stream.Send(&golang.PluginMessage{
 		PluginMessage: &golang.PluginMessage_Job{
 			Job: jobResult,
@@ -165,13 +183,60 @@ func (q *JobQueue) handleJob(ctx context.Context, job Job) {
 	})
 }
 
+// ReportProgress reports how far the job with the given id got, total is 0 if it's not known. It's meant to be
+// called from Job.Run as often as needed, updates are sent to the CLI at most every 500ms.
+func (q *JobQueue) ReportProgress(jobID string, current, total uint64) {
+	q.updateProgress(jobID, false, func(p *jobProgress) {
+		p.current = current
+		p.total = total
+	})
+}
+
+// ReportPhase sets the label of what the job with the given id is doing, e.g. "fetching metrics"
+func (q *JobQueue) ReportPhase(jobID, phase string) {
+	q.updateProgress(jobID, true, func(p *jobProgress) {
+		p.phase = phase
+	})
+}
+
+func (q *JobQueue) updateProgress(jobID string, force bool, update func(p *jobProgress)) {
+	p, ok := q.progress.Get(jobID)
+	if !ok {
+		return
+	}
+
+	p.lock.Lock()
+	update(p)
+	if !force && time.Since(p.lastSent) < progressInterval && (p.total == 0 || p.current < p.total) {
+		p.lock.Unlock()
+		return
+	}
+	p.lastSent = time.Now()
+	job := &golang.JobResult{
+		Id:          jobID,
+		Description: p.description,
+		Progress: &golang.JobProgress{
+			Current: p.current,
+			Total:   p.total,
+			Phase:   p.phase,
+		},
+	}
+	p.lock.Unlock()
+
+	q.stream.Send(&golang.PluginMessage{
+		PluginMessage: &golang.PluginMessage_Job{
+			Job: job,
+		},
+	})
+}
+
 func (q *JobQueue) run(ctx context.Context) {
 	defer func() {
 		if r := recover(); r != nil {
 			log.Printf("Job queue run panic: %v", r)
 			q.stream.Send(&golang.PluginMessage{
 				PluginMessage: &golang.PluginMessage_Err{
-					Err: &golang.Error{Error: fmt.Sprintf("%v", r)},
+					Err: &golang.Error{Error: fmt.Sprintf("%v", r), Code: golang.ErrorCode_ERROR_CODE_INTERNAL},
 				},
 			})
 
@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Logger sends log entries of the plugin to the CLI, which shows them in its logs page or on stderr with
+// --log-level. The entries are written to the plugin log file as well.
+type Logger struct {
+	stream *StreamController
+	fields map[string]string
+}
+
+func NewLogger(stream *StreamController) *Logger {
+	return &Logger{stream: stream, fields: map[string]string{}}
+}
+
+// Logger returns a logger sending its entries through the stream
+func (s *StreamController) Logger() *Logger {
+	return NewLogger(s)
+}
+
+// With returns a logger adding the field to every entry
+func (l *Logger) With(key string, value any) *Logger {
+	fields := make(map[string]string, len(l.fields)+1)
+	for k, v := range l.fields {
+		fields[k] = v
+	}
+	fields[key] = fmt.Sprint(value)
+	return &Logger{stream: l.stream, fields: fields}
+}
+
+// Debug logs the message with the fields given as alternating keys and values
+func (l *Logger) Debug(msg string, keyvals ...any) {
+	l.log(golang.LogLevel_LOG_LEVEL_DEBUG, msg, keyvals)
+}
+
+func (l *Logger) Info(msg string, keyvals ...any) {
+	l.log(golang.LogLevel_LOG_LEVEL_INFO, msg, keyvals)
+}
+
+func (l *Logger) Warn(msg string, keyvals ...any) {
+	l.log(golang.LogLevel_LOG_LEVEL_WARN, msg, keyvals)
+}
+
+func (l *Logger) Error(msg string, keyvals ...any) {
+	l.log(golang.LogLevel_LOG_LEVEL_ERROR, msg, keyvals)
+}
+
+func (l *Logger) log(level golang.LogLevel, msg string, keyvals []any) {
+	fields := make(map[string]string, len(l.fields)+len(keyvals)/2)
+	for k, v := range l.fields {
+		fields[k] = v
+	}
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		if i+1 < len(keyvals) {
+			fields[key] = fmt.Sprint(keyvals[i+1])
+		} else {
+			fields[key] = ""
+		}
+	}
+
+	// the file and line of the caller of Debug, Info, ... are logged
+	log.Output(3, fmt.Sprintf("%s %s%s", LogLevelName(level), msg, FormatLogFields(fields)))
+	if l.stream == nil {
+		return
+	}
+	l.stream.Send(&golang.PluginMessage{
+		PluginMessage: &golang.PluginMessage_Log{
+			Log: &golang.LogEntry{
+				Level:     level,
+				Timestamp: timestamppb.New(time.Now()),
+				Message:   msg,
+				Fields:    fields,
+			},
+		},
+	})
+}
+
+// LogLevelName returns the short name of the level, e.g. warn
+func LogLevelName(level golang.LogLevel) string {
+	switch level {
+	case golang.LogLevel_LOG_LEVEL_DEBUG:
+		return "debug"
+	case golang.LogLevel_LOG_LEVEL_INFO:
+		return "info"
+	case golang.LogLevel_LOG_LEVEL_WARN:
+		return "warn"
+	case golang.LogLevel_LOG_LEVEL_ERROR:
+		return "error"
+	}
+	return "info"
+}
+
+// ParseLogLevel parses a level name as accepted by --log-level
+func ParseLogLevel(name string) (golang.LogLevel, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return golang.LogLevel_LOG_LEVEL_DEBUG, nil
+	case "info":
+		return golang.LogLevel_LOG_LEVEL_INFO, nil
+	case "warn", "warning":
+		return golang.LogLevel_LOG_LEVEL_WARN, nil
+	case "error":
+		return golang.LogLevel_LOG_LEVEL_ERROR, nil
+	}
+	return golang.LogLevel_LOG_LEVEL_UNSPECIFIED, fmt.Errorf("log level %s not recognized, possible values: debug, info, warn, error", name)
+}
+
+// FormatLogFields formats the fields as key=value pairs sorted by key, with a leading space
+func FormatLogFields(fields map[string]string) string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(" " + k + "=" + fields[k])
+	}
+	return sb.String()
+}
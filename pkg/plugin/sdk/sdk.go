@@ -8,8 +8,11 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"log"
+	"net"
 	"os"
+	"strings"
 	"time"
 )
 
@@ -40,12 +43,36 @@ func (p *Plugin) runE(cmd *cobra.Command, args []string) error {
 		return errors.New("server address not provided")
 	}
 
-	conn, err := grpc.NewClient(serverFlag.Value.String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// the CLI listens on unix://<socket path> where it can and on localhost:<port> otherwise, grpc resolves both
+	serverAddr := serverFlag.Value.String()
+	if !strings.HasPrefix(serverAddr, "unix:") {
+		if _, _, err := net.SplitHostPort(serverAddr); err != nil {
+			return fmt.Errorf("invalid server address %s: %v", serverAddr, err)
+		}
+	}
+
+	// the CLI only accepts plugins presenting the token it started them with
+	token := os.Getenv(AuthTokenEnv)
+	os.Unsetenv(AuthTokenEnv)
+	return p.Run(ctx, serverAddr, token, os.Getenv(SessionEnv))
+}
+
+// Run connects to the CLI listening on serverAddr and serves it until it asks the plugin to shut down. The token
+// and session are the ones the CLI started the plugin with, empty if the plugin was started by hand.
+func (p *Plugin) Run(ctx context.Context, serverAddr, authToken, session string) error {
+	conn, err := grpc.NewClient(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
 
+	if authToken != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, AuthTokenMetadataKey, authToken)
+	}
+	if session != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, SessionMetadataKey, session)
+	}
+
 	client := golang.NewPluginClient(conn)
 	rawStream, err := client.Register(ctx)
 	if err != nil {
@@ -57,13 +84,18 @@ func (p *Plugin) runE(cmd *cobra.Command, args []string) error {
 
 	p.prc.SetStream(ctx, stream)
 	conf := p.prc.GetConfig(ctx)
+	conf.ProtocolVersion = ProtocolVersion
+	conf.Capabilities = pluginCapabilities(&conf)
 	stream.Send(&golang.PluginMessage{
 		PluginMessage: &golang.PluginMessage_Conf{
 			Conf: &conf,
 		},
 	})
+	// jobs run with their own context, so they can be cancelled on shutdown while the stream is flushed
+	jobCtx, cancelJobs := context.WithCancel(ctx)
+	defer cancelJobs()
 	jobQueue := NewJobQueue(p.jobMaxConcurrent, stream)
-	jobQueue.Start(ctx)
+	jobQueue.Start(jobCtx)
 
 	for {
 		if err := ctx.Err(); err != nil {
@@ -80,17 +112,30 @@ func (p *Plugin) runE(cmd *cobra.Command, args []string) error {
 		}
 
 		switch {
+		case msg.GetHello() != nil:
+			hello := msg.GetHello()
+			stream.setCapabilities(Negotiate(conf.Capabilities, hello.GetProtocolVersion(), hello.GetCapabilities()))
+		case msg.GetPing() != nil:
+			stream.Send(&golang.PluginMessage{
+				PluginMessage: &golang.PluginMessage_Pong{
+					Pong: &golang.Pong{Nonce: msg.GetPing().GetNonce()},
+				},
+			})
+		case msg.GetShutdown() != nil:
+			shutdown := msg.GetShutdown()
+			log.Printf("shutdown requested: %s", shutdown.GetReason())
+			cancelJobs()
+			p.shutdown(stream, rawStream, time.Duration(shutdown.GetGracePeriodSeconds())*time.Second)
+			return nil
 		case msg.GetReEvaluate() != nil:
-			p.prc.ReEvaluate(ctx, msg.GetReEvaluate())
+			p.prc.ReEvaluate(jobCtx, msg.GetReEvaluate())
 		case msg.GetStart() != nil:
 			startMsg := msg.GetStart()
-			err = p.prc.StartProcess(ctx, startMsg.GetCommand(), startMsg.GetFlags(), startMsg.GetKaytuAccessToken(), startMsg.GetDefaultPreferences(), jobQueue)
+			err = p.prc.StartProcess(jobCtx, startMsg.GetCommand(), startMsg.GetFlags(), startMsg.GetKaytuAccessToken(), startMsg.GetDefaultPreferences(), jobQueue)
 			if err != nil {
 				stream.Send(&golang.PluginMessage{
 					PluginMessage: &golang.PluginMessage_Err{
-						Err: &golang.Error{
-							Error: err.Error(),
-						},
+						Err: ErrorProto(err),
 					},
 				})
 				stream.CloseSend()
@@ -101,8 +146,38 @@ func (p *Plugin) runE(cmd *cobra.Command, args []string) error {
 	}
 }
 
+// shutdown sends the messages still queued, e.g. the results of the cancelled jobs, and closes the stream
+// within the grace period given by the CLI
+func (p *Plugin) shutdown(stream *StreamController, rawStream golang.Plugin_RegisterClient, gracePeriod time.Duration) {
+	if gracePeriod <= 0 {
+		gracePeriod = 5 * time.Second
+	}
+	// leave some of the grace period to close the stream before the CLI kills the plugin
+	deadline := time.Now().Add(gracePeriod * 3 / 4)
+
+	if !stream.Flush(time.Until(deadline)) {
+		log.Printf("shutdown: pending messages were not sent in time")
+		return
+	}
+	stream.CloseSend()
+	err := rawStream.CloseSend()
+	if err != nil {
+		log.Printf("shutdown: failed to close stream: %v", err)
+		return
+	}
+
+	// wait for the CLI to close its side, so the messages are not lost when the connection is closed
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	for {
+		if _, err := stream.Recv(ctx); err != nil {
+			return
+		}
+	}
+}
+
 func (p *Plugin) Execute(ctx context.Context) {
-	p.rootCmd.Flags().String("server", "", "")
+	p.rootCmd.Flags().String("server", "", "Address of the kaytu CLI, unix://<socket path> or host:port")
 
 	err := p.rootCmd.ExecuteContext(ctx)
 	if err != nil {
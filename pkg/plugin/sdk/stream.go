@@ -20,6 +20,12 @@ type StreamController struct {
 	sendClosed    atomic.Bool
 	receiveChan   chan *golang.ServerMessage
 	receiveClosed atomic.Bool
+
+	// pending counts the messages queued but not yet written to the stream
+	pending atomic.Int64
+
+	// capabilities negotiated with the CLI, nil until its ServerHello is received
+	capabilities atomic.Pointer[Capabilities]
 }
 
 func NewStreamController(stream golang.Plugin_RegisterClient) *StreamController {
@@ -51,7 +57,12 @@ func (s *StreamController) startReceiver(ctx context.Context) {
 			return
 		}
 		msg, err := s.stream.Recv()
-		if err != nil && !errors.Is(err, io.EOF) {
+		// the CLI closed the stream, e.g. after the plugin shut down
+		if errors.Is(err, io.EOF) {
+			s.CloseRecv()
+			return
+		}
+		if err != nil {
 			grpcStatus, ok := status.FromError(err)
 			if ok && grpcStatus.Code() == codes.Unavailable && grpcStatus.Message() == "error reading from server: EOF" {
 				s.CloseRecv()
@@ -91,6 +102,7 @@ func (s *StreamController) startSender(ctx context.Context) {
 			return
 		}
 		err := s.stream.Send(msg)
+		s.pending.Add(-1)
 		if err != nil && !errors.Is(err, io.EOF) {
 			log.Printf("send error: %v", err)
 			time.Sleep(1 * time.Second)
@@ -104,17 +116,52 @@ func (s *StreamController) Start(ctx context.Context) {
 	go s.startSender(ctx)
 }
 
+// ServerSupports reports whether the CLI handles the capability. CLIs that predate capability negotiation
+// are assumed to support the LegacyCapabilities.
+func (s *StreamController) ServerSupports(capability golang.Capability) bool {
+	capabilities := s.capabilities.Load()
+	if capabilities == nil {
+		return contains(LegacyCapabilities, capability)
+	}
+	return capabilities.Has(capability)
+}
+
+func (s *StreamController) setCapabilities(capabilities Capabilities) {
+	s.capabilities.Store(&capabilities)
+}
+
+// Send queues the message to the CLI, messages the CLI doesn't support are dropped
 func (s *StreamController) Send(msg *golang.PluginMessage) (err error) {
+	if capability := requiredCapability(msg); capability != golang.Capability_CAPABILITY_UNSPECIFIED && !s.ServerSupports(capability) {
+		log.Printf("dropping message the CLI does not support: %s", capability)
+		return nil
+	}
+
 	defer func() {
 		if r := recover(); r != nil {
+			s.pending.Add(-1)
 			s.sendClosed.Store(true)
 			err = fmt.Errorf("send is closed: %v", r)
 		}
 	}()
+	s.pending.Add(1)
 	s.sendChan <- msg
 	return nil
 }
 
+// Flush waits until the queued messages are written to the stream, it returns false if they weren't within
+// the timeout
+func (s *StreamController) Flush(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for s.pending.Load() > 0 {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return true
+}
+
 func (s *StreamController) Recv(ctx context.Context) (*golang.ServerMessage, error) {
 	select {
 	case msg, ok := <-s.receiveChan:
@@ -0,0 +1,113 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
+	"google.golang.org/grpc/metadata"
+)
+
+// NewSession returns a manager for one of several commands run against this manager's server, e.g. by
+// `kaytu optimize all`. Plugins started by the session register with its id, so their streams are routed to
+// the session's views and controllers instead of the ones set on this manager.
+func (m *Manager) NewSession(name string) (*Manager, error) {
+	id, err := newAuthToken()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Manager{
+		port:        m.port,
+		addr:        m.addr,
+		token:       m.token,
+		started:     true,
+		maxRestarts: m.maxRestarts,
+		logs:        m.logs,
+		logLevel:    m.logLevel,
+
+		registerTimeout: m.registerTimeout,
+		pingTimeout:     m.pingTimeout,
+		name:            name,
+		sessionId:       id[:16],
+		parent:          m,
+	}
+
+	m.pluginsLock.Lock()
+	defer m.pluginsLock.Unlock()
+	m.sessions = append(m.sessions, s)
+	return s, nil
+}
+
+// Name is the name the session was created with, it's shown as the source of its results
+func (m *Manager) Name() string {
+	return m.name
+}
+
+// SessionId is the id the plugins of the session register with, empty if the manager isn't a session
+func (m *Manager) SessionId() string {
+	return m.sessionId
+}
+
+// Sessions returns the sessions created with NewSession
+func (m *Manager) Sessions() []*Manager {
+	m.pluginsLock.Lock()
+	defer m.pluginsLock.Unlock()
+	return append([]*Manager(nil), m.sessions...)
+}
+
+// pluginEnv is added to the environment of the plugins started by the manager
+func (m *Manager) pluginEnv() []string {
+	var env []string
+	if m.token != "" {
+		env = append(env, fmt.Sprintf("%s=%s", sdk.AuthTokenEnv, m.token))
+	}
+	if m.sessionId != "" {
+		env = append(env, fmt.Sprintf("%s=%s", sdk.SessionEnv, m.sessionId))
+	}
+	return env
+}
+
+// sessionOf returns the session the registering plugin was started for, if any
+func (m *Manager) sessionOf(ctx context.Context) *Manager {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(sdk.SessionMetadataKey)
+	if len(values) != 1 {
+		return nil
+	}
+	for _, s := range m.Sessions() {
+		if s.sessionId == values[0] {
+			return s
+		}
+	}
+	return nil
+}
+
+// sessionNamed returns the session results with the given source belong to, or the manager itself
+func (m *Manager) sessionNamed(name string) *Manager {
+	for _, s := range m.Sessions() {
+		if s.name == name {
+			return s
+		}
+	}
+	return m
+}
+
+// markReady records that the session's plugin sent its results and reports whether all the sessions sharing
+// its controllers are done, so the shared controllers only stop initializing once every plugin is ready. A
+// session with its own custom ui is done on its own.
+func (m *Manager) markReady() bool {
+	if m.parent == nil {
+		return true
+	}
+
+	m.parent.pluginsLock.Lock()
+	defer m.parent.pluginsLock.Unlock()
+	m.ready = true
+	for _, s := range m.parent.sessions {
+		shared := s.optimizations == m.optimizations && s.pluginCustomOptimizations == m.pluginCustomOptimizations
+		if shared && !s.ready {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,81 @@
+package plugin
+
+import (
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"os/exec"
+	"time"
+)
+
+// ShutdownGracePeriod is how long plugins are given to cancel their jobs and exit before they're killed
+const ShutdownGracePeriod = 10 * time.Second
+
+type pluginProcess struct {
+	name string
+	cmd  *exec.Cmd
+	done chan struct{}
+}
+
+func (m *Manager) trackProcess(name string, cmd *exec.Cmd) *pluginProcess {
+	proc := &pluginProcess{name: name, cmd: cmd, done: make(chan struct{})}
+
+	m.pluginsLock.Lock()
+	defer m.pluginsLock.Unlock()
+	if m.processes == nil {
+		m.processes = map[string]*pluginProcess{}
+	}
+	m.processes[name] = proc
+	return proc
+}
+
+// Shutdown asks the running plugins, including the ones of the manager's sessions, to cancel their jobs and exit.
+// Plugins that don't support cancellation are killed right away, the others once the grace period is over.
+func (m *Manager) Shutdown(reason string, gracePeriod time.Duration) {
+	m.pluginsLock.Lock()
+	if m.stopped {
+		m.pluginsLock.Unlock()
+		return
+	}
+	// exits are expected from now on, they're not reported as crashes
+	m.stopped = true
+	m.pluginsLock.Unlock()
+
+	var processes []*pluginProcess
+	for _, mgr := range append([]*Manager{m}, m.Sessions()...) {
+		cancellable := map[string]bool{}
+		for _, plg := range mgr.runningPlugins() {
+			if !plg.Capabilities.Has(golang.Capability_CAPABILITY_CANCELLATION) {
+				continue
+			}
+			err := plg.Stream.Send(&golang.ServerMessage{
+				ServerMessage: &golang.ServerMessage_Shutdown{
+					Shutdown: &golang.Shutdown{
+						Reason:             reason,
+						GracePeriodSeconds: uint32(gracePeriod.Seconds()),
+					},
+				},
+			})
+			if err == nil {
+				cancellable[plg.Plugin.Config.Name] = true
+			}
+		}
+
+		mgr.pluginsLock.Lock()
+		for name, proc := range mgr.processes {
+			if !cancellable[name] {
+				_ = proc.cmd.Process.Kill()
+			}
+			processes = append(processes, proc)
+		}
+		mgr.pluginsLock.Unlock()
+	}
+
+	deadline := time.Now().Add(gracePeriod)
+	for _, proc := range processes {
+		select {
+		case <-proc.done:
+		case <-time.After(time.Until(deadline)):
+			_ = proc.cmd.Process.Kill()
+			<-proc.done
+		}
+	}
+}
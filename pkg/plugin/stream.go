@@ -0,0 +1,19 @@
+package plugin
+
+import (
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"sync"
+)
+
+// syncStream is the stream of a registered plugin. gRPC streams can't be sent on concurrently and messages are
+// sent to the plugin from the ui, the supervisor, the health checks and on shutdown, so the sends are serialized.
+type syncStream struct {
+	golang.Plugin_RegisterServer
+	sendLock sync.Mutex
+}
+
+func (s *syncStream) Send(msg *golang.ServerMessage) error {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	return s.Plugin_RegisterServer.Send(msg)
+}
@@ -0,0 +1,232 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/server"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	stderrTailLines = 20
+	stderrTailBytes = 64 * 1024
+)
+
+// PluginExitError is reported when a plugin process exits while the manager is still running it
+type PluginExitError struct {
+	Name     string
+	ExitCode int
+	// Stderr is the tail of the plugin's err logs written since it was started
+	Stderr string
+}
+
+func (e *PluginExitError) Error() string {
+	msg := fmt.Sprintf("plugin %s exited unexpectedly with exit code %d", e.Name, e.ExitCode)
+	// a plugin exiting cleanly on its own just didn't finish its work
+	if e.ExitCode == 0 {
+		msg = fmt.Sprintf("plugin %s exited before sending its results", e.Name)
+	}
+	if e.Stderr != "" {
+		msg += ", last output:\n" + e.Stderr
+	}
+	return msg
+}
+
+// SetRestartPolicy makes the manager restart a crashed plugin up to maxRestarts times, the last StartProcess
+// sent with SendStart is replayed once the restarted plugin registers
+func (m *Manager) SetRestartPolicy(maxRestarts int) {
+	m.maxRestarts = maxRestarts
+}
+
+// SendStart sends the StartProcess message to the running plugin and keeps it to be replayed on restart
+func (m *Manager) SendStart(name string, start *golang.StartProcess) error {
+	runningPlg := m.GetPlugin(name)
+	if runningPlg == nil {
+		return fmt.Errorf("running plugin not found: %s", name)
+	}
+
+	m.pluginsLock.Lock()
+	if m.lastStart == nil {
+		m.lastStart = map[string]*golang.StartProcess{}
+	}
+	m.lastStart[name] = start
+	m.pluginsLock.Unlock()
+
+	return runningPlg.Stream.Send(&golang.ServerMessage{
+		ServerMessage: &golang.ServerMessage_Start{
+			Start: start,
+		},
+	})
+}
+
+// supervise waits for the plugin process to exit. Unless the manager is stopping, the exit is reported to the
+// active view along with the tail of the plugin's err logs, or the plugin is restarted if the policy allows it.
+func (m *Manager) supervise(ctx context.Context, plg *server.Plugin, proc *pluginProcess, logOffset int64) {
+	name := plg.Config.Name
+	restarts := 0
+	for {
+		cmd := proc.cmd
+		_ = cmd.Wait()
+		close(proc.done)
+		if ctx.Err() != nil || m.isStopped() {
+			return
+		}
+
+		exitErr := &PluginExitError{
+			Name:     name,
+			ExitCode: cmd.ProcessState.ExitCode(),
+			Stderr:   tailLogs(errLogsPath(name), logOffset),
+		}
+		m.removeRunning(name)
+
+		if restarts >= m.maxRestarts {
+			m.publishPluginExit(exitErr)
+			return
+		}
+		restarts++
+
+		job := &golang.JobResult{
+			Id:          "restart-" + name,
+			Description: fmt.Sprintf("Restarting plugin %s after exit code %d (attempt %d of %d)", name, exitErr.ExitCode, restarts, m.maxRestarts),
+		}
+		m.publishJob(job)
+
+		logOffset = m.markLogOffset(name)
+		cmd, err := startPlugin(ctx, plg, m.ServerAddr(), m.pluginEnv())
+		if err != nil {
+			m.publishPluginExit(fmt.Errorf("failed to restart plugin %s: %v", name, err))
+			return
+		}
+		proc = m.trackProcess(name, cmd)
+		go m.replayStart(ctx, name, job)
+	}
+}
+
+// replayStart waits for the restarted plugin to register and resends the last StartProcess
+func (m *Manager) replayStart(ctx context.Context, name string, job *golang.JobResult) {
+	_, registerErr := m.waitForRegistration(ctx, name, restartRegisterTimeout, func(plg RunningPlugin) bool {
+		return plg.Plugin.Config.Name == name
+	})
+
+	m.pluginsLock.Lock()
+	start := m.lastStart[name]
+	m.pluginsLock.Unlock()
+
+	done := &golang.JobResult{
+		Id:          job.Id,
+		Description: job.Description,
+		Done:        true,
+	}
+	if registerErr != nil {
+		done.FailureMessage = fmt.Sprintf("plugin did not register after restart: %v", registerErr)
+	} else if start != nil {
+		err := m.SendStart(name, start)
+		if err != nil {
+			done.FailureMessage = err.Error()
+		}
+	}
+	m.publishJob(done)
+}
+
+func (m *Manager) removeRunning(name string) {
+	m.pluginsLock.Lock()
+	defer m.pluginsLock.Unlock()
+
+	var plugins []RunningPlugin
+	for _, plg := range m.plugins {
+		if plg.Plugin.Config.Name != name {
+			plugins = append(plugins, plg)
+		}
+	}
+	m.plugins = plugins
+}
+
+// isStopped tells whether the manager or, for sessions, the manager they belong to was shut down
+func (m *Manager) isStopped() bool {
+	m.pluginsLock.Lock()
+	stopped := m.stopped
+	m.pluginsLock.Unlock()
+
+	return stopped || (m.parent != nil && m.parent.isStopped())
+}
+
+func (m *Manager) publishJob(job *golang.JobResult) {
+	switch {
+	case m.RootCommandView != nil:
+		m.RootCommandView.PublishJobs(job)
+	case m.NonInteractiveView != nil:
+		m.NonInteractiveView.PublishJobs(job)
+	case m.jobs != nil:
+		m.jobs.Publish(job)
+	}
+}
+
+func (m *Manager) publishPluginExit(err error) {
+	switch {
+	case m.RootCommandView != nil:
+		m.RootCommandView.PublishPluginExit(err)
+	case m.NonInteractiveView != nil:
+		m.NonInteractiveView.PublishPluginExit(err)
+	default:
+		if m.jobs != nil {
+			m.jobs.PublishError(err)
+		}
+		// nothing else is coming from the plugin, so the pages stop waiting for results
+		if m.optimizations != nil {
+			m.optimizations.SetInitialization(false)
+		}
+		if m.pluginCustomOptimizations != nil {
+			m.pluginCustomOptimizations.SetInitialization(false)
+		}
+		if m.jobs == nil {
+			os.Stderr.WriteString(err.Error() + "\n")
+		}
+	}
+}
+
+func errLogsPath(name string) string {
+	return server.PluginLogsPath(name, "err")
+}
+
+func logsSize(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// tailLogs returns the last lines written to the log file after offset
+func tailLogs(path string, offset int64) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	size := logsSize(path)
+	// the file was rotated when the plugin started
+	if offset > size {
+		offset = 0
+	}
+	if size-offset > stderrTailBytes {
+		offset = size - stderrTailBytes
+	}
+	_, err = f.Seek(offset, io.SeekStart)
+	if err != nil {
+		return ""
+	}
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return ""
+	}
+
+	lines := strings.Split(strings.TrimRight(string(content), "\n"), "\n")
+	if len(lines) > stderrTailLines {
+		lines = lines[len(lines)-stderrTailLines:]
+	}
+	return strings.Join(lines, "\n")
+}
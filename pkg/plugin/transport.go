@@ -0,0 +1,12 @@
+//go:build !linux
+
+package plugin
+
+import (
+	"errors"
+	"net"
+)
+
+func listenPrivate() (net.Listener, string, func(), error) {
+	return nil, "", nil, errors.New("unix socket transport is not supported on this platform")
+}
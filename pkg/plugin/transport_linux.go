@@ -0,0 +1,69 @@
+//go:build linux
+
+package plugin
+
+import (
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/server"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// maxSocketPathLength is the size of sun_path on linux, including the terminating null byte
+const maxSocketPathLength = 108
+
+// listenPrivate listens on a unix socket only the current user can reach, inside a 0700 directory
+// created for this run under ~/.kaytu/run/
+func listenPrivate() (net.Listener, string, func(), error) {
+	runDir := server.RunDir()
+	err := os.MkdirAll(runDir, 0700)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	removeStaleRunDirs(runDir)
+
+	dir, err := os.MkdirTemp(runDir, fmt.Sprintf("%d-", os.Getpid()))
+	if err != nil {
+		return nil, "", nil, err
+	}
+	cleanup := func() {
+		os.RemoveAll(dir)
+	}
+
+	socketPath := filepath.Join(dir, "plugin.sock")
+	if len(socketPath) >= maxSocketPathLength {
+		cleanup()
+		return nil, "", nil, fmt.Errorf("socket path %s is too long", socketPath)
+	}
+
+	lis, err := net.Listen("unix", socketPath)
+	if err != nil {
+		cleanup()
+		return nil, "", nil, err
+	}
+	return lis, "unix://" + socketPath, cleanup, nil
+}
+
+// removeStaleRunDirs removes the socket directories left behind by runs that have exited,
+// each directory is prefixed with the pid of the CLI that created it
+func removeStaleRunDirs(runDir string) {
+	entries, err := os.ReadDir(runDir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		pidStr, _, _ := strings.Cut(entry.Name(), "-")
+		pid, err := strconv.Atoi(pidStr)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		if syscall.Kill(pid, 0) == syscall.ESRCH {
+			os.RemoveAll(filepath.Join(runDir, entry.Name()))
+		}
+	}
+}
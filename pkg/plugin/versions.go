@@ -0,0 +1,210 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/server"
+	"github.com/rogpeppe/go-internal/semver"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+const defaultRetainedPluginVersions = 3
+
+func pluginBinaryPath(name, version, pluginExt string) string {
+	return filepath.Join(server.PluginVersionsDir(name), version, strings.ReplaceAll(name, "/", "_")+pluginExt)
+}
+
+// syncDir flushes a rename within dir to disk, it's a no-op on platforms that can't open directories
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	defer d.Close()
+	_ = d.Sync()
+}
+
+func canonicalVersion(v string) string {
+	if !strings.HasPrefix(v, "v") {
+		return "v" + v
+	}
+	return v
+}
+
+// CompareVersions compares two plugin versions, with or without the v prefix, like semver.Compare
+func CompareVersions(a, b string) (int, error) {
+	a, b = canonicalVersion(a), canonicalVersion(b)
+	for _, v := range []string{a, b} {
+		if !semver.IsValid(v) {
+			return 0, fmt.Errorf("invalid version %s", strings.TrimPrefix(v, "v"))
+		}
+	}
+	return semver.Compare(a, b), nil
+}
+
+// migrateLegacyPlugin moves a binary installed directly in the plugins directory into its version directory,
+// so it is kept as a rollback target
+func migrateLegacyPlugin(plg *server.Plugin) error {
+	if plg == nil || plg.InstalledVersion != "" || plg.Config.Version == "" {
+		return nil
+	}
+
+	legacyPath := plg.Path()
+	info, err := os.Stat(legacyPath)
+	if err != nil || info.IsDir() {
+		return nil
+	}
+
+	pluginExt := filepath.Ext(legacyPath)
+	if runtime.GOOS != "windows" {
+		pluginExt = ""
+	}
+	// on unix the legacy binary has the same path as the versions directory, so it's moved away first
+	tmpPath := legacyPath + ".migrating"
+	err = os.Rename(legacyPath, tmpPath)
+	if err != nil {
+		return err
+	}
+	target := pluginBinaryPath(plg.Config.Name, plg.Config.Version, pluginExt)
+	err = os.MkdirAll(filepath.Dir(target), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(tmpPath, target)
+	if err != nil {
+		return err
+	}
+
+	plg.InstalledVersion = plg.Config.Version
+	return nil
+}
+
+// installedVersions returns the versions of the plugin kept on disk, newest first
+func installedVersions(name string) ([]string, error) {
+	entries, err := os.ReadDir(server.PluginVersionsDir(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var versions []string
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() != localStagingVersion {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return semver.Compare(canonicalVersion(versions[i]), canonicalVersion(versions[j])) > 0
+	})
+	return versions, nil
+}
+
+// pruneVersions removes the oldest versions of the plugin, keeping the current one and up to retain previous versions
+func pruneVersions(name, current string, retain int) error {
+	if retain <= 0 {
+		retain = defaultRetainedPluginVersions
+	}
+
+	versions, err := installedVersions(name)
+	if err != nil {
+		return err
+	}
+
+	kept := 0
+	for _, v := range versions {
+		if v == current {
+			continue
+		}
+		if kept < retain {
+			kept++
+			continue
+		}
+		err = os.RemoveAll(filepath.Join(server.PluginVersionsDir(name), v))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rollback switches the plugin back to a previously installed version, the newest one older than the current
+// version if toVersion is empty. The plugin is started to refresh its stored config and pinned afterward so
+// the automatic update does not install the newer release again.
+func (m *Manager) Rollback(ctx context.Context, pluginName, toVersion string) (*server.Plugin, error) {
+	cfg, err := server.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var current *server.Plugin
+	plugins := map[string]*server.Plugin{}
+	for _, plg := range cfg.Plugins {
+		if plg.Config.Name == pluginName || plg.Config.Name == normalizePluginAddr(pluginName) {
+			current = plg
+		}
+		plugins[plg.Config.Name] = plg
+	}
+	if current == nil {
+		return nil, fmt.Errorf("plugin not found")
+	}
+	pluginName = current.Config.Name
+
+	err = migrateLegacyPlugin(current)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := installedVersions(pluginName)
+	if err != nil {
+		return nil, err
+	}
+
+	target := ""
+	for _, v := range versions {
+		if v == current.InstalledVersion {
+			continue
+		}
+		if toVersion != "" {
+			if canonicalVersion(v) == canonicalVersion(toVersion) {
+				target = v
+				break
+			}
+			continue
+		}
+		if semver.Compare(canonicalVersion(v), canonicalVersion(current.InstalledVersion)) < 0 {
+			target = v
+			break
+		}
+	}
+	if target == "" {
+		if toVersion != "" {
+			return nil, fmt.Errorf("version %s of plugin %s is not installed, available versions: %s", toVersion, pluginName, strings.Join(versions, ", "))
+		}
+		return nil, errors.New("no previous version of the plugin is installed")
+	}
+
+	os.Stderr.WriteString(fmt.Sprintf("Rolling back plugin %s from version %s to %s\n", pluginName, current.InstalledVersion, target))
+	plg, err := m.registerInstalled(ctx, pluginName, target)
+	if err != nil {
+		return nil, err
+	}
+	plg.Pinned = true
+	plugins[pluginName] = plg
+
+	cfg.Plugins = nil
+	for _, v := range plugins {
+		cfg.Plugins = append(cfg.Plugins, v)
+	}
+	err = server.SetConfig(*cfg)
+	if err != nil {
+		return nil, err
+	}
+	return plg, nil
+}
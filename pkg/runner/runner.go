@@ -0,0 +1,473 @@
+// Package runner runs the commands of kaytu plugins from Go code, the way the kaytu CLI runs them: the plugin is
+// updated, started and waited for, the login and preferences are handled and the command is sent to it.
+//
+// Preferences are kept globally by the preferences package, so runs should not be made concurrently.
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/kaytu-io/kaytu/controller"
+	"github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/server"
+	"github.com/kaytu-io/kaytu/pkg/version"
+	"github.com/kaytu-io/kaytu/preferences"
+	"github.com/rogpeppe/go-internal/semver"
+	"os"
+	"time"
+)
+
+// ErrLoginRequired is returned when the command requires a login, no access token is available and no Login
+// function is given
+var ErrLoginRequired = errors.New("the command requires a login, run kaytu login")
+
+// Output modes of a run, the results are rendered in table, csv or json
+const (
+	OutputInteractive = "interactive"
+	OutputTable       = "table"
+	OutputCSV         = "csv"
+	OutputJSON        = "json"
+)
+
+type RunOptions struct {
+	// Plugin is the name of the plugin running the command, e.g. aws or kaytu-io/plugin-aws. If empty, the
+	// installed plugin providing the command is used.
+	Plugin string
+	// Command is the optimize command to run, or the plugin root command if RootCommand is set
+	Command     string
+	RootCommand bool
+	// Flags are sent to the plugin, flags of the command left out get their default value
+	Flags map[string]string
+	// Preferences are applied on top of the default preferences of the command
+	Preferences []preferences.PreferenceValueItem
+	// Output is the mode the results are shown in. Run renders the results in it, interactive leaves the
+	// views to be set by the caller. Defaults to json.
+	Output    string
+	AgentMode bool
+
+	// AccessToken is used instead of the one of the logged-in user
+	AccessToken string
+	// Login is called when the command requires a login and no access token is available
+	Login func(ctx context.Context) error
+
+	// SkipUpdate doesn't check for a newer version of the plugin before running it
+	SkipUpdate bool
+	// PluginDebugMode waits for a plugin started by hand on the debug port instead of starting it
+	PluginDebugMode bool
+	MaxRestarts     int
+	// RegisterTimeout is how long to wait for the plugin to start, defaults to plugin.DefaultRegisterTimeout
+	RegisterTimeout time.Duration
+	// LogLevel prints the plugin logs of at least this level to stderr
+	LogLevel golang.LogLevel
+	// Record writes the messages exchanged with the plugin to the file at this path, to be replayed with
+	// plugin.Manager.Replay
+	Record string
+
+	// Configure is called with the manager before the plugin is started, e.g. to keep its logs for a UI
+	Configure func(manager *plugin.Manager)
+	// AttachPlugin connects a plugin run in-process, e.g. a plugintest.Plugin, to the manager's server instead of
+	// starting the installed plugin. The plugin must register with the token and session it's given, and be
+	// named by Plugin.
+	AttachPlugin func(addr, token, session string)
+}
+
+// Result holds the results of a run
+type Result struct {
+	Plugin  string
+	Command string
+	// Items are the results of plugins shown with the default view
+	Items []*golang.OptimizationItem
+	// CustomItems are the results of plugins shown with their own charts
+	CustomItems []*golang.ChartOptimizationItem
+	// Export is the export prepared by the plugin itself, if it sent one
+	Export *golang.NonInteractiveExport
+	// Output is the results rendered in the output mode of the run
+	Output string
+	// FailedJobs are the failure messages of the plugin jobs that failed
+	FailedJobs []string
+}
+
+// Session is a plugin started for a command, ready to be sent the command
+type Session struct {
+	Manager *plugin.Manager
+	Plugin  *plugin.RunningPlugin
+	Command *golang.Command
+
+	accessToken   string
+	loginRequired bool
+	// defaults are the default preferences of the command, sent with the preferences of the run
+	defaults []*golang.PreferenceItem
+	recorder *plugin.Recorder
+	opts     RunOptions
+	// shared is set for the sessions of a manager started by the caller, see StartSession
+	shared bool
+}
+
+// Run runs the command and waits for its results
+func Run(ctx context.Context, opts RunOptions) (*Result, error) {
+	if opts.Output == OutputInteractive || opts.RootCommand {
+		return nil, errors.New("run only returns the results of optimize commands in table, csv or json")
+	}
+
+	s, err := Start(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer s.Close()
+
+	err = s.Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Results()
+}
+
+// Start updates and starts the plugin of the command and waits for it to register. The default preferences of
+// the command are set, so they can be read before the command is sent. Unless the output is interactive, the
+// manager's view for the output is set, so the results can be waited for with Results.
+func Start(ctx context.Context, opts RunOptions) (*Session, error) {
+	manager := plugin.New()
+	if opts.PluginDebugMode {
+		manager.SetListenPort(30422)
+		manager.SetTimeouts(plugin.DebugModeRegisterTimeout, 0)
+	}
+	if opts.RegisterTimeout > 0 {
+		manager.SetTimeouts(opts.RegisterTimeout, 0)
+	}
+	manager.SetRestartPolicy(opts.MaxRestarts)
+	if opts.Configure != nil {
+		opts.Configure(manager)
+	}
+	return start(ctx, manager, opts)
+}
+
+// StartSession is Start for one of several commands run together, e.g. by kaytu optimize all. The plugin is
+// started in a new session of the manager, named after the command, the manager's server must be started and its
+// timeouts, restart policy and logs are used. Closing the session leaves the server running.
+//
+// The preferences of the run are only sent to the command they belong to, checking they all belong to one of
+// the commands is left to the caller, e.g. with preferences.UpdateValues once all the sessions are started.
+func StartSession(ctx context.Context, manager *plugin.Manager, opts RunOptions) (*Session, error) {
+	if opts.PluginDebugMode {
+		return nil, errors.New("plugin debug mode needs a manager of its own")
+	}
+	session, err := manager.NewSession(opts.Command)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Configure != nil {
+		opts.Configure(session)
+	}
+	return start(ctx, session, opts)
+}
+
+func start(ctx context.Context, manager *plugin.Manager, opts RunOptions) (*Session, error) {
+	if opts.Output == "" && !opts.RootCommand {
+		opts.Output = OutputJSON
+	}
+	cfg, err := server.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// an attached plugin doesn't have to be installed, its command is found once it registered
+	var plg *server.Plugin
+	var cmd *golang.Command
+	name := opts.Plugin
+	if opts.AttachPlugin == nil {
+		plg, cmd, err = findCommand(opts)
+		if err != nil {
+			return nil, err
+		}
+		name = plg.Config.Name
+	} else if name == "" {
+		return nil, errors.New("the attached plugin must be named by the Plugin option")
+	}
+
+	switch {
+	case opts.RootCommand:
+		manager.SetRootCommandView()
+	case opts.Output != OutputInteractive:
+		manager.SetNonInteractiveView(opts.AgentMode)
+		manager.SetLogLevel(opts.LogLevel)
+	}
+
+	s := &Session{Manager: manager, Command: cmd, opts: opts, shared: manager.SessionId() != ""}
+	// a plugin in debug mode isn't updated and can register as soon as the server is up
+	if opts.PluginDebugMode {
+		err = s.record(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !s.shared {
+		err = manager.StartServer()
+		if err != nil {
+			s.CloseRecording()
+			return nil, err
+		}
+	}
+
+	switch {
+	case opts.AttachPlugin != nil:
+		err = s.record(name)
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+		opts.AttachPlugin(manager.ServerAddr(), manager.AuthToken(), manager.SessionId())
+	case !opts.PluginDebugMode:
+		if !opts.SkipUpdate && !plg.Pinned && !cfg.DisableAutoUpdate {
+			repoAddr := "github.com/" + plg.Config.Name
+			if plg.Config.Name == "aws" {
+				repoAddr = "aws"
+			}
+			err = manager.Install(ctx, repoAddr, "", false, false)
+			if err != nil {
+				os.Stderr.WriteString(fmt.Sprintf("plugin auto-update check failed due to %s\n", err))
+			}
+		}
+
+		// the recording starts after the update, which registers the new version to read its config
+		err = s.record(name)
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+		if manager.GetPlugin(name) == nil {
+			err = manager.StartPlugin(ctx, cmd.Name)
+			if err != nil {
+				s.Close()
+				return nil, err
+			}
+		}
+	}
+
+	s.Plugin, err = manager.WaitForPlugin(ctx, name)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+	err = s.prepare(cfg)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// record starts recording the plugins registering from now on if the run is recorded
+func (s *Session) record(pluginName string) error {
+	if s.opts.Record == "" {
+		return nil
+	}
+	recorder, err := plugin.NewRecorder(s.opts.Record, plugin.RecordingHeader{
+		Plugin:      pluginName,
+		Command:     s.opts.Command,
+		RootCommand: s.opts.RootCommand,
+		Output:      s.opts.Output,
+		AgentMode:   s.opts.AgentMode,
+	})
+	if err != nil {
+		return err
+	}
+	s.recorder = recorder
+	s.Manager.SetRecorder(recorder)
+	return nil
+}
+
+// prepare checks the running plugin can be used and sets up the preferences and views of the command
+func (s *Session) prepare(cfg *server.Config) error {
+	config := s.Plugin.Plugin.Config
+	if config.MinKaytuVersion != "" && semver.Compare("v"+version.VERSION, config.MinKaytuVersion) == -1 {
+		return fmt.Errorf("plugin %s requires kaytu version %s, please update your Kaytu CLI", config.Name, config.MinKaytuVersion)
+	}
+	if s.Command == nil {
+		s.Command = commandOf(&s.Plugin.Plugin, s.opts)
+		if s.Command == nil {
+			return fmt.Errorf("unknown command %s", s.opts.Command)
+		}
+	}
+
+	if s.Manager.NonInteractiveView != nil {
+		if s.Plugin.UsesCustomCharts() {
+			s.Manager.NonInteractiveView.SetOptimizations(nil, controller.NewOptimizations[golang.ChartOptimizationItem](),
+				config.OverviewChart, config.DevicesChart)
+		} else {
+			s.Manager.NonInteractiveView.SetOptimizations(controller.NewOptimizations[golang.OptimizationItem](),
+				nil, nil, nil)
+		}
+	}
+
+	s.accessToken = cfg.AccessToken
+	if s.opts.AccessToken != "" {
+		s.accessToken = s.opts.AccessToken
+	}
+	for _, rcmd := range config.Commands {
+		if rcmd.Name == s.Command.Name {
+			s.defaults = rcmd.DefaultPreferences
+			preferences.Update(rcmd.DefaultPreferences)
+			s.loginRequired = rcmd.LoginRequired
+			break
+		}
+	}
+
+	// the preferences of sessions run together may be meant for one of the others
+	if s.shared {
+		return nil
+	}
+	return preferences.UpdateValues(s.opts.Preferences)
+}
+
+// Send sends the command to the plugin, logging in first if the command requires it
+func (s *Session) Send(ctx context.Context) error {
+	flags, err := commandFlags(s.Command, s.opts)
+	if err != nil {
+		return err
+	}
+
+	// another session run along may have logged in already
+	if s.loginRequired && s.accessToken == "" {
+		cfg, err := server.GetConfig()
+		if err != nil {
+			return err
+		}
+		s.accessToken = cfg.AccessToken
+	}
+	if s.loginRequired && s.accessToken == "" {
+		if s.opts.Login == nil {
+			return ErrLoginRequired
+		}
+		err = s.opts.Login(ctx)
+		if err != nil {
+			return err
+		}
+		cfg, err := server.GetConfig()
+		if err != nil {
+			return err
+		}
+		s.accessToken = cfg.AccessToken
+	}
+
+	start := &golang.StartProcess{
+		Command:          s.Command.Name,
+		Flags:            flags,
+		KaytuAccessToken: s.accessToken,
+	}
+	if !s.opts.RootCommand {
+		start.DefaultPreferences = preferences.ForCommand(s.defaults, s.opts.Preferences)
+	}
+	return s.Manager.SendStart(s.Plugin.Plugin.Config.Name, start)
+}
+
+// Results waits for the results of the command sent with Send and renders them in the output of the run
+func (s *Session) Results() (*Result, error) {
+	v := s.Manager.NonInteractiveView
+	if v == nil {
+		return nil, errors.New("the results of interactive and root command runs are shown by their views")
+	}
+	err := v.WaitForResults()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Plugin:     s.Plugin.Plugin.Config.Name,
+		Command:    s.Command.Name,
+		Export:     v.NonInteractiveExport,
+		FailedJobs: v.FailedJobs(),
+	}
+	if v.Optimizations != nil {
+		result.Items = v.Optimizations.Items()
+	}
+	if v.PluginCustomOptimizations != nil {
+		result.CustomItems = v.PluginCustomOptimizations.Items()
+	}
+	result.Output, err = v.RenderResults(s.opts.Output)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Close asks the plugin to exit and stops the manager's server unless it was started by the caller, the
+// recording of the run is closed
+func (s *Session) Close() {
+	s.Manager.Shutdown("run finished", plugin.ShutdownGracePeriod)
+	if !s.shared {
+		s.Manager.StopServer()
+	}
+	if err := s.CloseRecording(); err != nil {
+		os.Stderr.WriteString(err.Error() + "\n")
+	}
+}
+
+// CloseRecording closes the recording of the run asked for with RunOptions.Record, it returns the first error
+// writing it
+func (s *Session) CloseRecording() error {
+	if s.recorder == nil {
+		return nil
+	}
+	return s.recorder.Close()
+}
+
+// findCommand returns the installed plugin and its command the options ask for
+func findCommand(opts RunOptions) (*server.Plugin, *golang.Command, error) {
+	var plugins []*server.Plugin
+	if opts.Plugin != "" {
+		plg, err := plugin.InstalledPlugin(opts.Plugin)
+		if err != nil {
+			return nil, nil, fmt.Errorf("plugin %s is not installed", opts.Plugin)
+		}
+		plugins = append(plugins, plg)
+	} else {
+		var err error
+		plugins, err = server.GetPlugins()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, plg := range plugins {
+		if cmd := commandOf(plg, opts); cmd != nil {
+			return plg, cmd, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("unknown command %s", opts.Command)
+}
+
+// commandOf returns the command of the plugin the options ask for, nil if the plugin doesn't have it
+func commandOf(plg *server.Plugin, opts RunOptions) *golang.Command {
+	commands := plg.Config.Commands
+	if opts.RootCommand {
+		commands = plg.Config.RootCommands
+	}
+	for _, cmd := range commands {
+		if cmd.Name == opts.Command {
+			return cmd
+		}
+	}
+	return nil
+}
+
+// commandFlags returns the flags sent to the plugin, with the defaults of the flags left out
+func commandFlags(cmd *golang.Command, opts RunOptions) (map[string]string, error) {
+	flags := map[string]string{}
+	for _, flag := range cmd.GetFlags() {
+		value, ok := opts.Flags[flag.Name]
+		if !ok {
+			value = flag.Default
+		}
+		if flag.Required && value == "" {
+			return nil, fmt.Errorf("%s requires the %s flag", cmd.Name, flag.Name)
+		}
+		flags[flag.Name] = value
+	}
+	if !opts.RootCommand {
+		flags["output"] = opts.Output
+	}
+	return flags, nil
+}
@@ -0,0 +1,106 @@
+package runner_test
+
+import (
+	"context"
+	"github.com/kaytu-io/kaytu/pkg/plugin"
+	"github.com/kaytu-io/kaytu/pkg/plugin/plugintest"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/runner"
+	"github.com/kaytu-io/kaytu/preferences"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	plg := plugintest.NewPlugin("fake", "ec2-instance").Script("ec2-instance",
+		plugintest.Job(&golang.JobResult{Id: "list", Description: "Listing instances"}),
+		plugintest.Item(&golang.OptimizationItem{Id: "i-0123", Name: "web", ResourceType: "t3.large"}),
+		plugintest.Job(&golang.JobResult{Id: "list", Description: "Listing instances", Done: true}),
+		plugintest.Ready(),
+	)
+	cmd := plg.Config.Commands[0]
+	cmd.Flags = []*golang.Flag{{Name: "region", Default: "us-east-1"}}
+	cmd.DefaultPreferences = []*golang.PreferenceItem{{Service: "EC2Instance", Key: "vCPU"}}
+
+	vCPU := "2"
+	result, err := runner.Run(context.Background(), runner.RunOptions{
+		Plugin:       plg.Config.Name,
+		Command:      "ec2-instance",
+		Output:       runner.OutputJSON,
+		AccessToken:  "secret-token",
+		Preferences:  []preferences.PreferenceValueItem{{Service: "EC2Instance", Key: "vCPU", Value: &vCPU}},
+		AttachPlugin: plg.Attach(t),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, plg.Config.Name, result.Plugin)
+	if assert.Len(t, result.Items, 1) {
+		assert.Equal(t, "i-0123", result.Items[0].GetId())
+	}
+	assert.JSONEq(t, `{"Items":[{"id":"i-0123","name":"web","resource_type":"t3.large"}]}`, result.Output)
+
+	starts := plg.Starts()
+	require.Len(t, starts, 1)
+	assert.Equal(t, map[string]string{"region": "us-east-1", "output": "json"}, starts[0].GetFlags())
+	assert.Equal(t, "secret-token", starts[0].GetKaytuAccessToken())
+	if assert.Len(t, starts[0].GetDefaultPreferences(), 1) {
+		assert.Equal(t, "2", starts[0].GetDefaultPreferences()[0].GetValue().GetValue())
+	}
+}
+
+func TestStartSessions(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	ec2 := plugintest.NewPlugin("aws", "ec2-instance").Script("ec2-instance",
+		plugintest.Item(&golang.OptimizationItem{Id: "default", Name: "ec2"}),
+		plugintest.Ready(),
+	)
+	ec2.Config.Commands[0].DefaultPreferences = []*golang.PreferenceItem{{Service: "EC2Instance", Key: "vCPU"}}
+	pods := plugintest.NewPlugin("kubernetes", "kubernetes-pods").Script("kubernetes-pods",
+		plugintest.Item(&golang.OptimizationItem{Id: "default", Name: "pods"}),
+		plugintest.Ready(),
+	)
+	pods.Config.Commands[0].DefaultPreferences = []*golang.PreferenceItem{{Service: "KubernetesPod", Key: "CPU"}}
+
+	manager := plugin.New()
+	require.NoError(t, manager.StartServer())
+	defer manager.StopServer()
+
+	vCPU, cpu := "2", "1"
+	values := []preferences.PreferenceValueItem{
+		{Service: "EC2Instance", Key: "vCPU", Value: &vCPU},
+		{Service: "KubernetesPod", Key: "CPU", Value: &cpu},
+	}
+	var sessions []*runner.Session
+	for _, plg := range []*plugintest.Plugin{ec2, pods} {
+		s, err := runner.StartSession(context.Background(), manager, runner.RunOptions{
+			Plugin:       plg.Config.Name,
+			Command:      plg.Config.Commands[0].Name,
+			Preferences:  values,
+			AccessToken:  "secret-token",
+			AttachPlugin: plg.Attach(t),
+		})
+		require.NoError(t, err)
+		defer s.Close()
+		sessions = append(sessions, s)
+	}
+	for _, s := range sessions {
+		require.NoError(t, s.Send(context.Background()))
+	}
+
+	for idx, name := range []string{"ec2", "pods"} {
+		result, err := sessions[idx].Results()
+		require.NoError(t, err)
+		if assert.Len(t, result.Items, 1) {
+			assert.Equal(t, name, result.Items[0].GetName())
+		}
+	}
+	// each plugin only gets the preferences of its command
+	for _, plg := range []*plugintest.Plugin{ec2, pods} {
+		starts := plg.Starts()
+		require.Len(t, starts, 1)
+		assert.Len(t, starts[0].GetDefaultPreferences(), 1)
+	}
+	assert.Equal(t, "2", ec2.Starts()[0].GetDefaultPreferences()[0].GetValue().GetValue())
+	assert.Equal(t, "1", pods.Starts()[0].GetDefaultPreferences()[0].GetValue().GetValue())
+}
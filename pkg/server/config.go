@@ -3,6 +3,7 @@ package server
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin/registry"
 	"github.com/kaytu-io/kaytu/pkg/version"
 	"os"
 	"path/filepath"
@@ -17,6 +18,18 @@ type Config struct {
 	Plugins         []*Plugin `json:"plugins"`
 	LastUpdateCheck time.Time `json:"lastUpdateCheck"`
 	LastVersion     string    `json:"lastVersion"`
+	// PluginPublicKey is an ed25519 public key used to verify the signature of plugin release checksums
+	PluginPublicKey string `json:"pluginPublicKey,omitempty"`
+	// PluginRetainedVersions is the number of previous plugin versions kept for rollback
+	PluginRetainedVersions int `json:"pluginRetainedVersions,omitempty"`
+	// DisableAutoUpdate stops commands from updating their plugin before running, use `kaytu plugin update` instead
+	DisableAutoUpdate bool `json:"disableAutoUpdate,omitempty"`
+	// Registries are the sources plugins are installed from, in priority order. Defaults to github releases.
+	Registries []registry.Config `json:"registries,omitempty"`
+	// LogMaxSizeMB, LogMaxBackups and LogMaxAgeDays limit the plugin log files, defaults are 10MB, 5 and 14 days
+	LogMaxSizeMB  int `json:"logMaxSizeMB,omitempty"`
+	LogMaxBackups int `json:"logMaxBackups,omitempty"`
+	LogMaxAgeDays int `json:"logMaxAgeDays,omitempty"`
 }
 
 var (
@@ -108,7 +121,33 @@ func SetConfig(data Config) error {
 		}
 	}
 
-	err = os.WriteFile(filepath.Join(home, ".kaytu", "kaytu-config.json"), configs, os.ModePerm)
+	// the config is written to a temp file and renamed over the old one, so an interrupted write can't corrupt it
+	f, err := os.CreateTemp(filepath.Join(home, ".kaytu"), "kaytu-config-*.json")
+	if err != nil {
+		return fmt.Errorf("[SetConfig]: %v", err)
+	}
+	defer os.Remove(f.Name())
+
+	// CreateTemp makes the file private, the config keeps the mode it had
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(filepath.Join(home, ".kaytu", "kaytu-config.json")); err == nil {
+		mode = info.Mode().Perm()
+	}
+	err = f.Chmod(mode)
+	if err == nil {
+		_, err = f.Write(configs)
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("[SetConfig]: %v", err)
+	}
+
+	err = os.Rename(f.Name(), filepath.Join(home, ".kaytu", "kaytu-config.json"))
 	if err != nil {
 		return fmt.Errorf("[SetConfig]: %v", err)
 	}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultLogMaxSizeMB  = 10
+	defaultLogMaxBackups = 5
+	defaultLogMaxAgeDays = 14
+)
+
+// PluginLogsPath is the file the plugin's stderr (kind err) or stdout (kind out) is written to
+func PluginLogsPath(name, kind string) string {
+	return filepath.Join(LogsDir(), fmt.Sprintf("%s.%s.logs", strings.ReplaceAll(name, "/", "_"), kind))
+}
+
+// RotateLogs moves the log file to a numbered backup (path.1 being the newest) once it's larger than the
+// configured size or wasn't written to within the configured age, and removes the backups over the
+// retention limits. It's called before a plugin is started, a running plugin keeps writing to its file.
+func RotateLogs(path string) error {
+	maxSize, maxBackups, maxAge := int64(defaultLogMaxSizeMB)<<20, defaultLogMaxBackups, defaultLogMaxAgeDays*24*time.Hour
+	if cfg, err := GetConfig(); err == nil {
+		if cfg.LogMaxSizeMB > 0 {
+			maxSize = int64(cfg.LogMaxSizeMB) << 20
+		}
+		if cfg.LogMaxBackups > 0 {
+			maxBackups = cfg.LogMaxBackups
+		}
+		if cfg.LogMaxAgeDays > 0 {
+			maxAge = time.Duration(cfg.LogMaxAgeDays) * 24 * time.Hour
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil && info.Size() > 0 && (info.Size() >= maxSize || time.Since(info.ModTime()) > maxAge) {
+		backups := LogBackups(path)
+		for i := len(backups) - 1; i >= 0; i-- {
+			err = os.Rename(backups[i].Path, fmt.Sprintf("%s.%d", path, backups[i].Index+1))
+			if err != nil {
+				return err
+			}
+		}
+		err = os.Rename(path, path+".1")
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, b := range LogBackups(path) {
+		if b.Index > maxBackups || time.Since(b.ModTime) > maxAge {
+			err = os.Remove(b.Path)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type LogBackup struct {
+	Path    string
+	Index   int
+	ModTime time.Time
+}
+
+// LogBackups returns the rotated backups of the log file, newest first
+func LogBackups(path string) []LogBackup {
+	matches, _ := filepath.Glob(path + ".*")
+
+	var backups []LogBackup
+	for _, m := range matches {
+		idx, err := strconv.Atoi(strings.TrimPrefix(m, path+"."))
+		if err != nil || idx <= 0 {
+			continue
+		}
+		info, err := os.Stat(m)
+		if err != nil {
+			continue
+		}
+		backups = append(backups, LogBackup{Path: m, Index: idx, ModTime: info.ModTime()})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Index < backups[j].Index
+	})
+	return backups
+}
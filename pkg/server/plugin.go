@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
-	"io/fs"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -13,27 +12,38 @@ import (
 
 type Plugin struct {
 	Config *golang.RegisterConfig `json:"config"`
+	// Pinned plugins are kept on their installed version and skipped by the automatic update
+	Pinned bool `json:"pinned,omitempty"`
+	// InstalledVersion is the directory under PluginVersionsDir the active binary is installed in,
+	// empty for plugins installed before versions were kept side by side
+	InstalledVersion string `json:"installedVersion,omitempty"`
 }
 
 func (p *Plugin) Path() string {
 	executableName := strings.ReplaceAll(p.Config.Name, "/", "_")
-	_ = filepath.WalkDir(PluginDir(), func(path string, info fs.DirEntry, err error) error {
-		if info.IsDir() {
-			return nil
+	if p.InstalledVersion != "" {
+		dir := filepath.Join(PluginVersionsDir(p.Config.Name), p.InstalledVersion)
+		return filepath.Join(dir, findExecutable(dir, executableName))
+	}
+	return filepath.Join(PluginDir(), findExecutable(PluginDir(), executableName))
+}
+
+func findExecutable(dir, executableName string) string {
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
 		}
-		pluginExt := filepath.Ext(path)
+		pluginExt := filepath.Ext(entry.Name())
 		if runtime.GOOS != "windows" {
 			pluginExt = ""
 		}
 
-		if strings.TrimSuffix(filepath.Base(path), pluginExt) == executableName {
-			executableName = info.Name()
-			return nil
+		if strings.TrimSuffix(entry.Name(), pluginExt) == executableName {
+			return entry.Name()
 		}
-		return nil
-	})
-
-	return filepath.Join(PluginDir(), executableName)
+	}
+	return executableName
 }
 
 func GetPlugins() ([]*Plugin, error) {
@@ -73,9 +83,20 @@ func PluginDir() string {
 	return dir
 }
 
+// PluginVersionsDir is the directory holding the installed versions of a plugin, one sub directory per version
+func PluginVersionsDir(name string) string {
+	return filepath.Join(PluginDir(), strings.ReplaceAll(name, "/", "_"))
+}
+
 func LogsDir() string {
 	home, _ := os.UserHomeDir()
 	dir := filepath.Join(home, ".kaytu", "logs")
 	os.MkdirAll(dir, os.ModePerm)
 	return dir
 }
+
+// RunDir holds the sockets plugins connect to, one private directory per running CLI
+func RunDir() string {
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".kaytu", "run")
+}
@@ -7,4 +7,5 @@ var (
 	ContactUsMessage = fmt.Sprintf("You have reached the limit for this user and organization.\n"+
 		"Contact us and request for increase in limits:\n"+
 		"%s", ContactUsEmail)
+	AuthExpiredMessage = "Your credentials have expired, please log in again (kaytu login, or refresh your cloud provider credentials)"
 )
@@ -3,6 +3,7 @@ package utils
 import (
 	"github.com/spf13/cobra"
 	"strconv"
+	"time"
 )
 
 func ReadIntFlag(cmd *cobra.Command, name string) int64 {
@@ -24,3 +25,9 @@ func ReadBooleanFlag(cmd *cobra.Command, name string) bool {
 	i, _ := strconv.ParseBool(str)
 	return i
 }
+
+func ReadDurationFlag(cmd *cobra.Command, name string) time.Duration {
+	str := ReadStringFlag(cmd, name)
+	d, _ := time.ParseDuration(str)
+	return d
+}
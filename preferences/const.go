@@ -3,6 +3,7 @@ package preferences
 import (
 	"fmt"
 	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -61,6 +62,30 @@ func UpdateValues(pis []PreferenceValueItem) error {
 	return nil
 }
 
+// ForCommand returns the preferences sent to a command: its own defaults with the values given for them. Values
+// of preferences the command doesn't have are left out, they belong to other commands run along with it.
+func ForCommand(defaults []*golang.PreferenceItem, values []PreferenceValueItem) []*golang.PreferenceItem {
+	var prefs []*golang.PreferenceItem
+	for _, d := range defaults {
+		pref := proto.Clone(d).(*golang.PreferenceItem)
+		for _, pi := range values {
+			if pref.Service != pi.Service || pref.Key != pi.Key {
+				continue
+			}
+			if pi.Value == nil {
+				pref.Value = nil
+			} else {
+				pref.Value = wrapperspb.String(*pi.Value)
+			}
+			if pi.Pinned != nil {
+				pref.Pinned = *pi.Pinned
+			}
+		}
+		prefs = append(prefs, pref)
+	}
+	return prefs
+}
+
 func DefaultPreferences() []*golang.PreferenceItem {
 	return defaultPref
 }
@@ -15,6 +15,7 @@ const (
 	Page_Preferences     = 2
 	Page_Jobs            = 3
 	Page_ContactUs       = 4
+	Page_Logs            = 5
 )
 
 type Page interface {
@@ -42,6 +43,7 @@ func NewApp(
 	preferencesPage PreferencesPage[golang.OptimizationItem],
 	jobsPage JobsPage,
 	contactUsPage ContactUsPage,
+	logsPage LogsPage,
 ) *App {
 	app := &App{}
 	optimizationsPage = optimizationsPage.SetApp(app)
@@ -52,6 +54,7 @@ func NewApp(
 		preferencesPage,
 		jobsPage,
 		contactUsPage,
+		logsPage,
 	}
 	return app
 }
@@ -62,6 +65,7 @@ func NewCustomPluginApp(
 	preferencesPage PreferencesPage[golang.ChartOptimizationItem],
 	jobsPage JobsPage,
 	contactUsPage ContactUsPage,
+	logsPage LogsPage,
 ) *App {
 	app := &App{}
 	optimizationsPage = optimizationsPage.SetApp(app)
@@ -72,6 +76,7 @@ func NewCustomPluginApp(
 		preferencesPage,
 		jobsPage,
 		contactUsPage,
+		logsPage,
 	}
 	return app
 }
@@ -131,6 +136,8 @@ func (m *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, tea.Quit
 			case "ctrl+j":
 				changePageCmd = tea.Batch(changePageCmd, m.ChangePage(Page_Jobs))
+			case "ctrl+l":
+				changePageCmd = tea.Batch(changePageCmd, m.ChangePage(Page_Logs))
 			case "esc":
 				if !m.ignoreESC && len(m.history) > 0 {
 					var page PageEnum
@@ -0,0 +1,92 @@
+package view
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SourceResult is the non-interactive output of one of the commands run together, e.g. by `kaytu optimize all`
+type SourceResult struct {
+	Plugin  string
+	Command string
+	Result  string
+}
+
+// CombineResults merges the outputs of several commands into one, grouped by the command they came from:
+// tables get a heading per command, csv rows get a leading Source column and json results are listed under
+// Sources.
+func CombineResults(format string, results []SourceResult) (string, error) {
+	switch format {
+	case "table":
+		var sb strings.Builder
+		for _, r := range results {
+			sb.WriteString(bold.Sprintf("%s (%s)", r.Command, r.Plugin))
+			sb.WriteString("\n")
+			sb.WriteString(r.Result)
+			if !strings.HasSuffix(r.Result, "\n") {
+				sb.WriteString("\n")
+			}
+			sb.WriteString("\n")
+		}
+		return sb.String(), nil
+	case "csv":
+		s := &bytes.Buffer{}
+		writer := csv.NewWriter(s)
+		var headers []string
+		for _, r := range results {
+			records, err := csv.NewReader(strings.NewReader(r.Result)).ReadAll()
+			if err != nil {
+				return "", fmt.Errorf("failed to read results of %s: %v", r.Command, err)
+			}
+			if len(records) == 0 {
+				continue
+			}
+			// plugins with custom charts have their own columns, the header is repeated when it changes
+			if !slices.Equal(headers, records[0]) {
+				headers = records[0]
+				err = writer.Write(append([]string{"Source"}, headers...))
+				if err != nil {
+					return "", err
+				}
+			}
+			for _, record := range records[1:] {
+				err = writer.Write(append([]string{r.Command}, record...))
+				if err != nil {
+					return "", err
+				}
+			}
+		}
+		writer.Flush()
+		return s.String(), writer.Error()
+	case "json":
+		type jsonSource struct {
+			Plugin  string
+			Command string
+			Result  json.RawMessage
+		}
+		jsonValue := struct {
+			Sources []jsonSource
+		}{}
+		for _, r := range results {
+			result := json.RawMessage(r.Result)
+			if strings.TrimSpace(r.Result) == "" {
+				result = json.RawMessage("null")
+			}
+			jsonValue.Sources = append(jsonValue.Sources, jsonSource{
+				Plugin:  r.Plugin,
+				Command: r.Command,
+				Result:  result,
+			})
+		}
+		jsonData, err := json.Marshal(jsonValue)
+		if err != nil {
+			return "", err
+		}
+		return string(jsonData), nil
+	}
+	return "", fmt.Errorf("output mode not recognized: %s", format)
+}
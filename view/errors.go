@@ -0,0 +1,27 @@
+package view
+
+import (
+	"fmt"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
+	"github.com/kaytu-io/kaytu/pkg/utils"
+)
+
+// errorCodeMessage tells the user how to deal with errors they can act on, it's empty for other codes
+func errorCodeMessage(code golang.ErrorCode) string {
+	switch code {
+	case golang.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED:
+		return utils.ContactUsMessage
+	case golang.ErrorCode_ERROR_CODE_AUTH_EXPIRED:
+		return utils.AuthExpiredMessage
+	}
+	return ""
+}
+
+// withErrorCodeMessage appends the message of the error's code, if it has one
+func withErrorCodeMessage(err error) error {
+	if msg := errorCodeMessage(sdk.ErrorCode(err)); msg != "" {
+		return fmt.Errorf("%w\n%s", err, msg)
+	}
+	return err
+}
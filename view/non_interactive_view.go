@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/fatih/color"
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -13,6 +14,7 @@ import (
 	"github.com/kaytu-io/kaytu/pkg/utils"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -52,8 +54,10 @@ type NonInteractiveView struct {
 	NonInteractiveExport *golang.NonInteractiveExport
 
 	errorChan chan error
+	exitChan  chan error
 
-	jobChan chan *golang.JobResult
+	jobChan     chan *golang.JobResult
+	progressLog *progressLog
 
 	resultsReady chan bool
 	output       *os.File
@@ -65,7 +69,9 @@ func NewNonInteractiveView(agentMode bool) *NonInteractiveView {
 		runningJobsMap: sync.Map{},
 		failedJobsMap:  sync.Map{},
 		jobChan:        make(chan *golang.JobResult, 10000),
+		progressLog:    newProgressLog(),
 		errorChan:      make(chan error, 10000),
+		exitChan:       make(chan error, 10000),
 		resultsReady:   make(chan bool),
 		output:         os.Stdout,
 	}
@@ -93,7 +99,12 @@ func (v *NonInteractiveView) PublishJobs(jobs *golang.JobResult) {
 }
 
 func (v *NonInteractiveView) PublishError(err error) {
-	v.errorChan <- err
+	v.errorChan <- withErrorCodeMessage(err)
+}
+
+// PublishPluginExit stops waiting for results, the plugin process is gone
+func (v *NonInteractiveView) PublishPluginExit(err error) {
+	v.exitChan <- err
 }
 
 func (v *NonInteractiveView) PublishResultsReady(ready *golang.ResultsReady) {
@@ -112,12 +123,16 @@ func (v *NonInteractiveView) SetDevicesChartDefinition(devicesChart *golang.Char
 	v.DevicesChart = devicesChart
 }
 
+// WaitAndShowResults waits for the plugin to send all its results and writes them to the output, the jobs are
+// handled as they come like WaitForResults does
 func (v *NonInteractiveView) WaitAndShowResults(nonInteractiveFlag string) error {
-	go v.WaitForJobs()
 	for {
 		select {
+		case job := <-v.jobChan:
+			v.handleJob(job)
 		case ready := <-v.resultsReady:
 			if ready == true {
+				v.handlePendingJobs()
 				if v.Optimizations != nil {
 					for v.Optimizations.IsProcessing() {
 						os.Stderr.WriteString(fmt.Sprintf("%s - Export still processing, waiting for it to finish...\n", time.Now().Format(time.RFC3339)))
@@ -240,16 +255,44 @@ func (v *NonInteractiveView) WaitAndShowResults(nonInteractiveFlag string) error
 		case err := <-v.errorChan:
 			os.Stderr.WriteString("\n" + err.Error())
 			return nil
+		case err := <-v.exitChan:
+			return err
 		}
 	}
 }
 
+// ResultsError is returned by WaitForResults when the plugin reported an error instead of its results
+type ResultsError struct {
+	Err error
+}
+
+func (e *ResultsError) Error() string {
+	return e.Err.Error()
+}
+
 func (v *NonInteractiveView) WaitAndReturnResults(nonInteractiveFlag string) (string, error) {
-	go v.WaitForJobs()
+	err := v.WaitForResults()
+	var resultsErr *ResultsError
+	if errors.As(err, &resultsErr) {
+		os.Stderr.WriteString(resultsErr.Error() + "\n")
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return v.RenderResults(nonInteractiveFlag)
+}
+
+// WaitForResults waits for the plugin to send all its results, it returns a ResultsError if the plugin
+// reported an error instead. The jobs are handled as they come, so the failed ones are known once it returns.
+func (v *NonInteractiveView) WaitForResults() error {
 	for {
 		select {
+		case job := <-v.jobChan:
+			v.handleJob(job)
 		case ready := <-v.resultsReady:
 			if ready == true {
+				v.handlePendingJobs()
 				if v.Optimizations != nil {
 					for v.Optimizations.IsProcessing() {
 						os.Stderr.WriteString(fmt.Sprintf("%s - Export still processing, waiting for it to finish...\n", time.Now().Format(time.RFC3339)))
@@ -261,95 +304,124 @@ func (v *NonInteractiveView) WaitAndReturnResults(nonInteractiveFlag string) (st
 						time.Sleep(1 * time.Second)
 					}
 				}
-				if nonInteractiveFlag == "table" {
-					var str string
-					var err error
-					if v.Optimizations != nil {
-						str, err = v.OptimizationsString()
-						if err != nil {
-							return "", err
-						}
-					} else {
-						str, err = v.CustomOptimizationsString()
-						if err != nil {
-							return "", err
-						}
-					}
-					return str, nil
-				} else if nonInteractiveFlag == "csv" {
-					var csvHeaders []string
-					var csvRows [][]string
-					if v.Optimizations != nil {
-						csvHeaders, csvRows = exportCsv(v.Optimizations.Items())
-					} else {
-						csvHeaders, csvRows = v.exportCustomCsv(v.PluginCustomOptimizations.Items())
-					}
-					s := &bytes.Buffer{}
-					writer := csv.NewWriter(s)
+				return nil
+			}
+		case err := <-v.errorChan:
+			return &ResultsError{Err: err}
+		case err := <-v.exitChan:
+			return err
+		}
+	}
+}
 
-					err := writer.Write(csvHeaders)
-					if err != nil {
-						return "", err
-					}
+// RenderResults returns the results in the given format (table, csv or json), the export prepared by the plugin
+// is preferred like WaitAndShowResults does
+func (v *NonInteractiveView) RenderResults(format string) (string, error) {
+	if export := v.NonInteractiveExport; export != nil {
+		switch {
+		case format == "table" && export.Table != "":
+			return export.Table, nil
+		case format == "csv" && export.Csv != nil:
+			s := &bytes.Buffer{}
+			writer := csv.NewWriter(s)
+			for _, row := range export.Csv {
+				if row == nil {
+					continue
+				}
+				err := writer.Write(row.Row)
+				if err != nil {
+					return "", err
+				}
+			}
+			writer.Flush()
+			return s.String(), nil
+		case format == "json" && export.Json != "":
+			return export.Json, nil
+		}
+	}
 
-					for _, row := range csvRows {
-						err := writer.Write(row)
-						if err != nil {
-							return "", err
-						}
-					}
-					writer.Flush()
-					return s.String(), nil
-				} else if nonInteractiveFlag == "json" {
-					var jsonData []byte
-					var err error
-					if v.Optimizations != nil {
-						jsonValue := struct {
-							Items []*golang.OptimizationItem
-						}{
-							Items: v.Optimizations.Items(),
-						}
-						jsonData, err = json.Marshal(jsonValue)
-						if err != nil {
-							return "", err
-						}
-					} else {
-						jsonData, err = json.Marshal(convertOptimizeJson(v.PluginCustomOptimizations.Items(), v.agentMode))
-						if err != nil {
-							return "", err
-						}
-					}
+	if format == "table" {
+		var str string
+		var err error
+		if v.Optimizations != nil {
+			str, err = v.OptimizationsString()
+			if err != nil {
+				return "", err
+			}
+		} else {
+			str, err = v.CustomOptimizationsString()
+			if err != nil {
+				return "", err
+			}
+		}
+		return str, nil
+	} else if format == "csv" {
+		var csvHeaders []string
+		var csvRows [][]string
+		if v.Optimizations != nil {
+			csvHeaders, csvRows = exportCsv(v.Optimizations.Items())
+		} else {
+			csvHeaders, csvRows = v.exportCustomCsv(v.PluginCustomOptimizations.Items())
+		}
+		s := &bytes.Buffer{}
+		writer := csv.NewWriter(s)
 
-					return string(jsonData), nil
-				} else {
-					os.Stderr.WriteString("output mode not recognized!\n")
-				}
-				return "", nil
+		err := writer.Write(csvHeaders)
+		if err != nil {
+			return "", err
+		}
+
+		for _, row := range csvRows {
+			err := writer.Write(row)
+			if err != nil {
+				return "", err
 			}
-		case err := <-v.errorChan:
-			os.Stderr.WriteString(err.Error() + "\n")
-			return "", nil
 		}
+		writer.Flush()
+		return s.String(), nil
+	} else if format == "json" {
+		var jsonData []byte
+		var err error
+		if v.Optimizations != nil {
+			jsonValue := struct {
+				Items []*golang.OptimizationItem
+			}{
+				Items: v.Optimizations.Items(),
+			}
+			jsonData, err = json.Marshal(jsonValue)
+			if err != nil {
+				return "", err
+			}
+		} else {
+			jsonData, err = json.Marshal(convertOptimizeJson(v.PluginCustomOptimizations.Items(), v.agentMode))
+			if err != nil {
+				return "", err
+			}
+		}
+
+		return string(jsonData), nil
+	} else {
+		os.Stderr.WriteString("output mode not recognized!\n")
 	}
+	return "", nil
+}
+
+// FailedJobs returns the failure messages of the jobs that failed, sorted
+func (v *NonInteractiveView) FailedJobs() []string {
+	var res []string
+	v.failedJobsMap.Range(func(key, value any) bool {
+		res = append(res, value.(string))
+		return true
+	})
+	sort.Strings(res)
+	return res
 }
 
 func (v *NonInteractiveView) WaitForJobs() {
 	for {
 		select {
 		case job := <-v.jobChan:
-			if !job.Done {
-				os.Stderr.WriteString(job.Description + " Running...\n")
-				v.runningJobsMap.Store(job.Id, job.Description)
-			} else {
-				os.Stderr.WriteString(job.Description + " Done.\n")
-				v.runningJobsMap.Delete(job.Id)
-			}
-			if len(job.FailureMessage) > 0 {
-				if utils.MatchesLimitPattern(fmt.Sprintf("%s failed due to %s", job.Description, job.FailureMessage)) {
-					v.errorChan <- fmt.Errorf(utils.ContactUsMessage)
-				}
-				v.failedJobsMap.Store(job.Id, fmt.Sprintf("%s failed due to %s", job.Description, job.FailureMessage))
-			}
+			v.handleJob(job)
 		case err := <-v.errorChan:
 			os.Stderr.WriteString(err.Error() + "\n")
 			v.statusErr = fmt.Sprintf("Failed due to %v", err)
@@ -358,6 +430,37 @@ func (v *NonInteractiveView) WaitForJobs() {
 	}
 }
 
+func (v *NonInteractiveView) handleJob(job *golang.JobResult) {
+	if v.progressLog.update(job) {
+		return
+	}
+	if !job.Done {
+		os.Stderr.WriteString(job.Description + " Running...\n")
+		v.runningJobsMap.Store(job.Id, job.Description)
+	} else {
+		os.Stderr.WriteString(job.Description + " Done.\n")
+		v.runningJobsMap.Delete(job.Id)
+	}
+	if len(job.FailureMessage) > 0 {
+		if msg := errorCodeMessage(job.GetError().GetCode()); msg != "" {
+			v.errorChan <- errors.New(msg)
+		}
+		v.failedJobsMap.Store(job.Id, fmt.Sprintf("%s failed due to %s", job.Description, job.FailureMessage))
+	}
+}
+
+// handlePendingJobs handles the jobs published before the results were ready
+func (v *NonInteractiveView) handlePendingJobs() {
+	for {
+		select {
+		case job := <-v.jobChan:
+			v.handleJob(job)
+		default:
+			return
+		}
+	}
+}
+
 func exportCsv(items []*golang.OptimizationItem) ([]string, [][]string) {
 	headers := []string{
 		"Item-ID", "Item-ResourceType", "Item-Region", "Item-Platform", "Item-TotalSave",
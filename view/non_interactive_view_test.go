@@ -0,0 +1,158 @@
+package view_test
+
+import (
+	"errors"
+	"github.com/kaytu-io/kaytu/pkg/plugin/plugintest"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func ec2Plugin() *plugintest.Plugin {
+	return plugintest.NewPlugin("fake", "ec2-instance").Script("ec2-instance",
+		plugintest.Job(&golang.JobResult{Id: "list", Description: "Listing instances"}),
+		plugintest.Item(&golang.OptimizationItem{
+			Id:           "i-0123",
+			Name:         "web",
+			ResourceType: "t3.large",
+			Region:       "us-east-1",
+			Platform:     "linux",
+			Devices: []*golang.Device{
+				{
+					DeviceId:       "i-0123",
+					ResourceType:   "EC2 Instance",
+					Runtime:        "730 hours",
+					CurrentCost:    60.74,
+					RightSizedCost: 30.37,
+					Properties: []*golang.Property{
+						{Key: "vCPU", Current: "2", Average: "0.3", Max: "0.9", Recommended: "1"},
+						{Key: "Memory", Current: "8 GiB", Average: "2.1 GiB", Max: "3.2 GiB", Recommended: "4 GiB"},
+					},
+				},
+				{
+					DeviceId:       "vol-0456",
+					ResourceType:   "EBS Volume",
+					Runtime:        "730 hours",
+					CurrentCost:    10,
+					RightSizedCost: 8,
+					Properties: []*golang.Property{
+						{Key: "Size", Current: "100 GB", Recommended: "80 GB"},
+					},
+				},
+			},
+		}),
+		plugintest.Item(&golang.OptimizationItem{
+			Id:           "i-0789",
+			Name:         "batch",
+			ResourceType: "m5.xlarge",
+			Region:       "eu-west-1",
+			Platform:     "linux",
+			Skipped:      true,
+			SkipReason:   "spot instance",
+		}),
+		plugintest.Job(&golang.JobResult{Id: "list", Description: "Listing instances", Done: true}),
+		plugintest.Ready(),
+	)
+}
+
+func rdsPlugin() *plugintest.Plugin {
+	plg := plugintest.NewPlugin("fake-charts", "rds-instance")
+	plg.Config.OverviewChart = &golang.ChartDefinition{Columns: []*golang.ChartColumnItem{
+		{Id: "resource_id", Name: "Resource ID", Width: 20},
+		{Id: "engine", Name: "Engine", Width: 10},
+		{Id: "total_saving", Name: "Net Savings", Width: 12},
+	}}
+	plg.Config.DevicesChart = &golang.ChartDefinition{Columns: []*golang.ChartColumnItem{
+		{Id: "resource_id", Name: "Resource ID", Width: 20},
+		{Id: "current_cost", Name: "Current Cost", Width: 12},
+	}}
+	return plg.Script("rds-instance",
+		plugintest.ChartItem(&golang.ChartOptimizationItem{
+			OverviewChartRow: &golang.ChartRow{
+				RowId: "db-1",
+				Values: map[string]*golang.ChartRowItem{
+					"resource_id":  {Value: "db-1"},
+					"engine":       {Value: "postgres"},
+					"total_saving": {Value: "$42.00", SortValue: 42},
+				},
+			},
+			DevicesChartRows: []*golang.ChartRow{
+				{
+					RowId: "db-1",
+					Values: map[string]*golang.ChartRowItem{
+						"resource_id":  {Value: "db-1"},
+						"current_cost": {Value: "$120.00", SortValue: 120},
+					},
+				},
+			},
+			DevicesProperties: map[string]*golang.Properties{
+				"db-1": {Properties: []*golang.Property{
+					{Key: "vCPU", Current: "4", Average: "0.8", Max: "1.5", Recommended: "2"},
+				}},
+			},
+		}),
+		plugintest.Summary("1 database can be right sized"),
+		plugintest.Ready(),
+	)
+}
+
+func TestNonInteractiveViewOutputs(t *testing.T) {
+	for _, format := range []string{"table", "csv", "json"} {
+		t.Run(format, func(t *testing.T) {
+			h := plugintest.New(t, ec2Plugin(), false)
+			h.Start("ec2-instance", nil)
+
+			plugintest.AssertGolden(t, "ec2-instance."+format, h.Results(format))
+		})
+	}
+}
+
+func TestNonInteractiveViewCustomChartOutputs(t *testing.T) {
+	for _, format := range []string{"table", "csv", "json"} {
+		t.Run(format, func(t *testing.T) {
+			h := plugintest.New(t, rdsPlugin(), false)
+			h.Start("rds-instance", nil)
+
+			plugintest.AssertGolden(t, "rds-instance."+format, h.Results(format))
+		})
+	}
+}
+
+func TestNonInteractiveViewPluginExport(t *testing.T) {
+	plg := plugintest.NewPlugin("fake", "ec2-instance").Script("ec2-instance",
+		&golang.PluginMessage{PluginMessage: &golang.PluginMessage_NonInteractive{
+			NonInteractive: &golang.NonInteractiveExport{Json: `{"prepared":true}`},
+		}},
+		plugintest.Ready(),
+	)
+	h := plugintest.New(t, plg, false)
+	h.Start("ec2-instance", nil)
+
+	assert.Equal(t, `{"prepared":true}`, h.Results("json"))
+}
+
+func TestNonInteractiveViewFailedJobs(t *testing.T) {
+	plg := plugintest.NewPlugin("fake", "ec2-instance").Script("ec2-instance",
+		plugintest.Job(&golang.JobResult{Id: "metrics", Description: "Getting metrics of i-0123", Done: true,
+			FailureMessage: "access denied"}),
+		plugintest.Ready(),
+	)
+	h := plugintest.New(t, plg, false)
+	h.Start("ec2-instance", nil)
+
+	assert.NoError(t, h.Wait())
+	assert.Equal(t, []string{"Getting metrics of i-0123 failed due to access denied"}, h.Manager.NonInteractiveView.FailedJobs())
+}
+
+func TestNonInteractiveViewPluginError(t *testing.T) {
+	plg := plugintest.NewPlugin("fake", "ec2-instance").Script("ec2-instance",
+		plugintest.Error(sdk.NewError(golang.ErrorCode_ERROR_CODE_PERMISSION_DENIED, errors.New("no credentials found"))),
+	)
+	h := plugintest.New(t, plg, false)
+	h.Start("ec2-instance", nil)
+
+	err := h.Wait()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no credentials found")
+}
@@ -48,7 +48,7 @@ func (m JobsPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m JobsPage) View() string {
-	runningJobs := m.jobController.RunningJobs()
+	runningJobs := m.jobController.RunningJobsWithProgress()
 	failedJobs := m.jobController.FailedJobs()
 
 	var lines []string
@@ -66,15 +66,21 @@ func (m JobsPage) View() string {
 	}
 
 	for idx, v := range runningJobs {
-		line := fmt.Sprintf("       - %s", v)
+		job := v.Description
+		if v.Progress != nil {
+			job += " " + progressBar(v.Progress)
+		}
+		line := fmt.Sprintf("       - %s", job)
 		if idx == 0 {
-			line = fmt.Sprintf(" jobs: - %s", v)
+			line = fmt.Sprintf(" jobs: - %s", job)
 		}
 		lines = append(lines, wordwrap.String(line, m.GetWidth()))
 	}
 
 	if len(runningJobs) == 0 {
 		lines = append(lines, " no running job")
+	} else if eta := m.jobController.ETA(); eta > 0 {
+		lines = append(lines, "", fmt.Sprintf(" estimated time left: %s", formatETA(eta)))
 	}
 
 	return "\n" + statusErr + strings.Join(lines, "\n") + "\n\n" +
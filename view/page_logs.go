@@ -0,0 +1,115 @@
+package view
+
+import (
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kaytu-io/kaytu/controller"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"github.com/kaytu-io/kaytu/pkg/plugin/sdk"
+	"github.com/kaytu-io/kaytu/pkg/style"
+	"github.com/kaytu-io/kaytu/view/responsive"
+	"github.com/muesli/reflow/wordwrap"
+	"strings"
+	"time"
+)
+
+type LogsPage struct {
+	helpController *controller.Help
+	logsController *controller.Logs
+	statusBar      StatusBarView
+
+	minLevel golang.LogLevel
+	// scroll is the number of lines hidden below the view, zero follows the latest logs
+	scroll int
+
+	responsive.ResponsiveView
+}
+
+func NewLogsPage(logsController *controller.Logs, helpController *controller.Help, statusBar StatusBarView) LogsPage {
+	return LogsPage{
+		logsController: logsController,
+		helpController: helpController,
+		statusBar:      statusBar,
+		minLevel:       golang.LogLevel_LOG_LEVEL_DEBUG,
+	}
+}
+
+func (m LogsPage) OnClose() Page {
+	return m
+}
+func (m LogsPage) OnOpen() Page {
+	m.scroll = 0
+	m.helpController.SetKeyMap([]string{
+		"↑/↓: scroll",
+		"l: change level",
+		"esc: back to main menu",
+		"q/ctrl+c: exit",
+	})
+
+	return m
+}
+
+func (m LogsPage) Init() tea.Cmd { return nil }
+
+func (m LogsPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.scroll++
+		case "down", "j":
+			if m.scroll > 0 {
+				m.scroll--
+			}
+		case "end":
+			m.scroll = 0
+		case "l":
+			m.minLevel++
+			if m.minLevel > golang.LogLevel_LOG_LEVEL_ERROR {
+				m.minLevel = golang.LogLevel_LOG_LEVEL_DEBUG
+			}
+			m.scroll = 0
+		}
+	}
+
+	newStatusBar, _ := m.statusBar.Update(msg)
+	m.statusBar = newStatusBar.(StatusBarView)
+
+	return m, nil
+}
+
+func (m LogsPage) View() string {
+	var lines []string
+	for _, r := range m.logsController.Records(m.minLevel) {
+		line := fmt.Sprintf(" %s [%s] %-5s %s%s", r.Time.Local().Format(time.TimeOnly), r.Source,
+			sdk.LogLevelName(r.Level), r.Message, sdk.FormatLogFields(r.Fields))
+		line = wordwrap.String(line, m.GetWidth())
+		if r.Level >= golang.LogLevel_LOG_LEVEL_WARN {
+			line = style.ErrorStyle.Render(line)
+		}
+		lines = append(lines, strings.Split(line, "\n")...)
+	}
+	if len(lines) == 0 {
+		lines = append(lines, " no plugin logs")
+	}
+
+	header := fmt.Sprintf(" logs, level %s and above:", sdk.LogLevelName(m.minLevel))
+	height := m.GetHeight() - m.statusBar.Height() - 4
+	if height < 1 {
+		height = 1
+	}
+	end := len(lines) - m.scroll
+	if end < height {
+		end = min(height, len(lines))
+	}
+	start := max(end-height, 0)
+
+	return "\n" + header + "\n" + strings.Join(lines[start:end], "\n") + "\n\n" +
+		m.statusBar.View()
+}
+func (m LogsPage) SetResponsiveView(rv responsive.ResponsiveViewInterface) Page {
+	m.ResponsiveView = rv.(responsive.ResponsiveView)
+	return m
+}
@@ -22,6 +22,8 @@ type OverviewPage struct {
 	sortColumnIdx int
 	sortDesc      bool
 	columns       []table.Column
+	// grouped is set once results of several commands are shown, rows are then grouped by their source
+	grouped bool
 
 	helpController *controller.Help
 	optimizations  *controller.Optimizations[golang.OptimizationItem]
@@ -108,6 +110,12 @@ func (m OverviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, filterCmd
 	}
 
+	if !m.grouped && m.optimizations.HasSources() {
+		m.grouped = true
+		m.columns = append([]table.Column{table.NewColumn("7", "Source", 15).WithFiltered(true)}, m.columns...)
+		m.table = m.table.WithHorizontalFreezeColumnCount(2).SortByAsc("7")
+	}
+
 	var rows Rows
 	for _, i := range m.optimizations.Items() {
 		totalSaving := 0.0
@@ -137,11 +145,11 @@ func (m OverviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		} else if i.Loading {
 			row[5] = "loading"
 		}
-		row = append(row, "→")
+		row = append(row, "→", m.optimizations.ItemSource(i))
 		rows = append(rows, row)
 	}
 	var columns []table.Column
-	for idx, column := range m.columns {
+	for _, column := range m.columns {
 		width := len(column.Title())
 		for _, row := range rows.ToTableRows() {
 			cell := row.Data[column.Key()]
@@ -153,7 +161,7 @@ func (m OverviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				width = len(cellContent)
 			}
 		}
-		if idx == 6 {
+		if column.Key() == "6" {
 			width = -1
 		}
 		columns = append(columns, table.NewColumn(column.Key(), column.Title(), width+2).WithFiltered(true))
@@ -182,9 +190,8 @@ func (m OverviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.table.TotalRows() == 0 {
 				break
 			}
-			selectedInstanceID := m.table.HighlightedRow().Data["0"]
 			for _, i := range m.optimizations.Items() {
-				if selectedInstanceID == i.Id && !i.Skipped && !i.Loading && !i.LazyLoadingEnabled {
+				if m.isHighlighted(i) && !i.Skipped && !i.Loading && !i.LazyLoadingEnabled {
 					m.optimizations.SelectItem(i)
 					changePageCmd = m.app.ChangePage(Page_Preferences)
 					m.clearScreen = true
@@ -205,7 +212,7 @@ func (m OverviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					i.LazyLoadingEnabled = false
 					i.Loading = true
 					m.optimizations.SendItem(i)
-					m.optimizations.ReEvaluate(i.Id, i.Preferences)
+					m.optimizations.ReEvaluate(i, i.Preferences)
 				}
 			}
 
@@ -215,23 +222,34 @@ func (m OverviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					i.LazyLoadingEnabled = false
 					i.Loading = true
 					m.optimizations.SendItem(i)
-					m.optimizations.ReEvaluate(i.Id, i.Preferences)
+					m.optimizations.ReEvaluate(i, i.Preferences)
 				}
 			}
 		case "s":
+			if m.grouped {
+				m.table = m.table.SortByAsc("7")
+			}
 			if m.sortDesc {
 				m.sortDesc = false
-				m.table = m.table.SortByAsc(fmt.Sprintf("%d", m.sortColumnIdx))
+				if m.grouped {
+					m.table = m.table.ThenSortByAsc(fmt.Sprintf("%d", m.sortColumnIdx))
+				} else {
+					m.table = m.table.SortByAsc(fmt.Sprintf("%d", m.sortColumnIdx))
+				}
 			} else {
 				m.sortColumnIdx = (m.sortColumnIdx + 1) % 6
 				m.sortDesc = true
-				m.table = m.table.SortByDesc(fmt.Sprintf("%d", m.sortColumnIdx))
+				if m.grouped {
+					m.table = m.table.ThenSortByDesc(fmt.Sprintf("%d", m.sortColumnIdx))
+				} else {
+					m.table = m.table.SortByDesc(fmt.Sprintf("%d", m.sortColumnIdx))
+				}
 			}
 
 			var columns []table.Column
-			for idx, col := range m.columns {
+			for _, col := range m.columns {
 				name := col.Title()
-				if m.sortColumnIdx == idx {
+				if col.Key() == fmt.Sprintf("%d", m.sortColumnIdx) {
 					if m.sortDesc {
 						name = name + " ↓"
 					} else {
@@ -258,17 +276,16 @@ func (m OverviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				break
 			}
 
-			selectedInstanceID := m.table.HighlightedRow().Data["0"]
 			for _, i := range m.optimizations.Items() {
-				if selectedInstanceID == i.Id && !i.Skipped && !i.Loading && !i.LazyLoadingEnabled {
+				if m.isHighlighted(i) && !i.Skipped && !i.Loading && !i.LazyLoadingEnabled {
 					m.optimizations.SelectItem(i)
 					changePageCmd = m.app.ChangePage(Page_ResourceDetails)
 					break
-				} else if selectedInstanceID == i.Id && !i.Skipped && i.LazyLoadingEnabled {
+				} else if m.isHighlighted(i) && !i.Skipped && i.LazyLoadingEnabled {
 					i.LazyLoadingEnabled = false
 					i.Loading = true
 					m.optimizations.SendItem(i)
-					m.optimizations.ReEvaluate(i.Id, i.Preferences)
+					m.optimizations.ReEvaluate(i, i.Preferences)
 				}
 			}
 		}
@@ -314,6 +331,16 @@ func (m OverviewPage) View() string {
 	)
 }
 
+// isHighlighted reports whether the item is the one of the highlighted row, items of different sources can
+// have the same id
+func (m OverviewPage) isHighlighted(i *golang.OptimizationItem) bool {
+	row := m.table.HighlightedRow()
+	if row.Data["0"] != i.Id {
+		return false
+	}
+	return !m.grouped || row.Data["7"] == m.optimizations.ItemSource(i)
+}
+
 func (m OverviewPage) SetApp(app *App) OverviewPage {
 	m.app = app
 	return m
@@ -309,7 +309,7 @@ func (m *PluginCustomOverviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					i.LazyLoadingEnabled = false
 					i.Loading = true
 					m.optimizations.SendItem(i)
-					m.optimizations.ReEvaluate(i.GetOverviewChartRow().GetRowId(), i.GetPreferences())
+					m.optimizations.ReEvaluate(i, i.GetPreferences())
 				}
 			}
 
@@ -319,7 +319,7 @@ func (m *PluginCustomOverviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					i.LazyLoadingEnabled = false
 					i.Loading = true
 					m.optimizations.SendItem(i)
-					m.optimizations.ReEvaluate(i.GetOverviewChartRow().GetRowId(), i.GetPreferences())
+					m.optimizations.ReEvaluate(i, i.GetPreferences())
 				}
 			}
 
@@ -346,7 +346,7 @@ func (m *PluginCustomOverviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					i.LazyLoadingEnabled = false
 					i.Loading = true
 					m.optimizations.SendItem(i)
-					m.optimizations.ReEvaluate(i.GetOverviewChartRow().GetRowId(), i.GetPreferences())
+					m.optimizations.ReEvaluate(i, i.GetPreferences())
 				}
 			}
 		}
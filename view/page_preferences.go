@@ -109,9 +109,8 @@ func (m PreferencesPage[T]) OnClose() Page {
 				}
 				castedSelectedItem.Preferences = prefs
 				castedSelectedItem.Loading = true
-				var a = any(*castedSelectedItem).(T)
-				m.optimizations.SendItem(&a)
-				m.optimizations.ReEvaluate(castedSelectedItem.Id, prefs)
+				m.optimizations.SendItem(selectedItem)
+				m.optimizations.ReEvaluate(selectedItem, prefs)
 			case *golang.ChartOptimizationItem:
 				if castedSelectedItem == nil {
 					continue
@@ -125,9 +124,8 @@ func (m PreferencesPage[T]) OnClose() Page {
 				}
 				castedSelectedItem.Preferences = prefs
 				castedSelectedItem.Loading = true
-				var a = any(*castedSelectedItem).(T)
-				m.optimizations.SendItem(&a)
-				m.optimizations.ReEvaluate(castedSelectedItem.GetOverviewChartRow().GetRowId(), prefs)
+				m.optimizations.SendItem(selectedItem)
+				m.optimizations.ReEvaluate(selectedItem, prefs)
 			}
 		}
 	} else {
@@ -140,12 +138,12 @@ func (m PreferencesPage[T]) OnClose() Page {
 			castedSelectedItem.Preferences = prefs
 			castedSelectedItem.Loading = true
 			m.optimizations.SendItem(selectedItem)
-			m.optimizations.ReEvaluate(castedSelectedItem.Id, prefs)
+			m.optimizations.ReEvaluate(selectedItem, prefs)
 		case *golang.ChartOptimizationItem:
 			castedSelectedItem.Preferences = prefs
 			castedSelectedItem.Loading = true
 			m.optimizations.SendItem(selectedItem)
-			m.optimizations.ReEvaluate(castedSelectedItem.GetOverviewChartRow().GetRowId(), prefs)
+			m.optimizations.ReEvaluate(selectedItem, prefs)
 		}
 	}
 	return m
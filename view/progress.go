@@ -0,0 +1,82 @@
+package view
+
+import (
+	"fmt"
+	"github.com/kaytu-io/kaytu/controller"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
+	"os"
+	"strings"
+	"time"
+)
+
+const progressBarWidth = 20
+
+// progressBar renders the progress of a job, e.g. "[========>           ] 42% (42/100) fetching metrics, eta 12s"
+func progressBar(p *controller.JobProgress) string {
+	var parts []string
+	if fraction := p.Fraction(); fraction >= 0 {
+		filled := int(fraction * progressBarWidth)
+		bar := strings.Repeat("=", filled)
+		if filled < progressBarWidth {
+			bar += ">" + strings.Repeat(" ", progressBarWidth-filled-1)
+		}
+		parts = append(parts, fmt.Sprintf("[%s] %d%% (%d/%d)", bar, int(fraction*100), p.Current, p.Total))
+	} else if p.Current > 0 {
+		parts = append(parts, fmt.Sprintf("(%d)", p.Current))
+	}
+	if p.Phase != "" {
+		parts = append(parts, p.Phase)
+	}
+	line := strings.Join(parts, " ")
+	if p.ETA > 0 {
+		line += ", eta " + formatETA(p.ETA)
+	}
+	return line
+}
+
+func formatETA(eta time.Duration) string {
+	return eta.Round(time.Second).String()
+}
+
+// progressLogInterval limits how often the progress of a job is written to stderr
+const progressLogInterval = 2 * time.Second
+
+// progressLog writes the progress reported by running jobs to stderr in the non-interactive views
+type progressLog struct {
+	progress *controller.JobsProgress
+	logged   map[string]time.Time
+}
+
+func newProgressLog() *progressLog {
+	return &progressLog{
+		progress: controller.NewJobsProgress(),
+		logged:   map[string]time.Time{},
+	}
+}
+
+// update tracks the job and reports whether it's a progress update, which doesn't change the state of the job
+func (l *progressLog) update(job *golang.JobResult) bool {
+	l.progress.Update(job)
+	if job.Done {
+		delete(l.logged, job.Id)
+	}
+	if job.GetProgress() == nil || job.Done {
+		return false
+	}
+
+	p := l.progress.Get(job.Id)
+	if p == nil {
+		return true
+	}
+	if last, ok := l.logged[job.Id]; ok && time.Since(last) < progressLogInterval && p.Fraction() < 1 {
+		return true
+	}
+	l.logged[job.Id] = time.Now()
+
+	line := job.Description + " " + progressBar(p)
+	if eta := l.progress.ETA(); eta > p.ETA {
+		line += fmt.Sprintf(" (all jobs: eta %s)", formatETA(eta))
+	}
+	os.Stderr.WriteString(line + "\n")
+	return true
+}
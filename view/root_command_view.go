@@ -1,18 +1,20 @@
 package view
 
 import (
+	"errors"
 	"fmt"
 	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
-	"github.com/kaytu-io/kaytu/pkg/utils"
 	"os"
 )
 
 type RootCommandView struct {
 	statusErr string
 	errorChan chan error
+	exitChan  chan error
 
 	jobChan     chan *golang.JobResult
 	summaryChan chan *golang.ResultSummary
+	progressLog *progressLog
 
 	resultsReady chan bool
 }
@@ -21,7 +23,9 @@ func NewRootCommandView() *RootCommandView {
 	v := &RootCommandView{
 		jobChan:      make(chan *golang.JobResult, 10000),
 		summaryChan:  make(chan *golang.ResultSummary, 10000),
+		progressLog:  newProgressLog(),
 		errorChan:    make(chan error, 10000),
+		exitChan:     make(chan error, 10000),
 		resultsReady: make(chan bool),
 	}
 	return v
@@ -32,7 +36,12 @@ func (v *RootCommandView) PublishJobs(jobs *golang.JobResult) {
 }
 
 func (v *RootCommandView) PublishError(err error) {
-	v.errorChan <- err
+	v.errorChan <- withErrorCodeMessage(err)
+}
+
+// PublishPluginExit stops waiting for results, the plugin process is gone
+func (v *RootCommandView) PublishPluginExit(err error) {
+	v.exitChan <- err
 }
 
 func (v *RootCommandView) PublishResultsReady(ready *golang.ResultsReady) {
@@ -51,14 +60,17 @@ func (v *RootCommandView) WaitAndShowResults() error {
 				return nil
 			}
 		case job := <-v.jobChan:
+			if v.progressLog.update(job) {
+				continue
+			}
 			if !job.Done {
 				os.Stderr.WriteString(job.Description + " Running...\n")
 			} else {
 				os.Stderr.WriteString(job.Description + " Done.\n")
 			}
 			if len(job.FailureMessage) > 0 {
-				if utils.MatchesLimitPattern(fmt.Sprintf("%s failed due to %s", job.Description, job.FailureMessage)) {
-					v.errorChan <- fmt.Errorf(utils.ContactUsMessage)
+				if msg := errorCodeMessage(job.GetError().GetCode()); msg != "" {
+					v.errorChan <- errors.New(msg)
 				}
 			}
 		case err := <-v.errorChan:
@@ -66,6 +78,8 @@ func (v *RootCommandView) WaitAndShowResults() error {
 			v.statusErr = fmt.Sprintf("Failed due to %v", err)
 		case summary := <-v.summaryChan:
 			os.Stderr.WriteString(summary.Message + "\n")
+		case err := <-v.exitChan:
+			return err
 		}
 	}
 }
@@ -0,0 +1,77 @@
+package view
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kaytu-io/kaytu/pkg/style"
+	"strings"
+)
+
+// SourcesApp shows the results of several sources, e.g. the commands of kaytu optimize all, each one in its own
+// App. Sources shown with the default charts share one App, ctrl+n switches to the next App.
+type SourcesApp struct {
+	apps          []*App
+	names         []string
+	active        int
+	width, height int
+}
+
+func NewSourcesApp() *SourcesApp {
+	return &SourcesApp{}
+}
+
+// Add adds an App showing the results of the named sources
+func (m *SourcesApp) Add(name string, app *App) {
+	m.names = append(m.names, name)
+	m.apps = append(m.apps, app)
+}
+
+// ChangePage changes the page of the App that is shown
+func (m *SourcesApp) ChangePage(id PageEnum) tea.Cmd {
+	return m.apps[m.active].ChangePage(id)
+}
+
+func (m *SourcesApp) Init() tea.Cmd {
+	var cmds []tea.Cmd
+	for _, app := range m.apps {
+		cmds = append(cmds, app.Init())
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m *SourcesApp) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m.updateActive(m.appSize())
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+n" && len(m.apps) > 1 {
+			m.active = (m.active + 1) % len(m.apps)
+			// the App didn't get the size changes made while another one was shown
+			return m.updateActive(m.appSize())
+		}
+	}
+	return m.updateActive(msg)
+}
+
+func (m *SourcesApp) updateActive(msg tea.Msg) (tea.Model, tea.Cmd) {
+	_, cmd := m.apps[m.active].Update(msg)
+	return m, cmd
+}
+
+// appSize is the size left to the shown App under the line of sources
+func (m *SourcesApp) appSize() tea.WindowSizeMsg {
+	return tea.WindowSizeMsg{Width: m.width, Height: m.height - 1}
+}
+
+func (m *SourcesApp) View() string {
+	var names []string
+	for idx, name := range m.names {
+		if idx == m.active {
+			names = append(names, style.HighlightStyle.Render(" "+name+" "))
+		} else {
+			names = append(names, " "+name+" ")
+		}
+	}
+	header := strings.Join(names, "|") + style.HelpStyle.Render("   press ctrl+n to see the next results")
+	return header + "\n" + m.apps[m.active].View()
+}
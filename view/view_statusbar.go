@@ -5,6 +5,7 @@ import (
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/kaytu-io/kaytu/controller"
+	"github.com/kaytu-io/kaytu/pkg/plugin/proto/src/golang"
 	"github.com/kaytu-io/kaytu/pkg/style"
 	"strings"
 )
@@ -12,14 +13,15 @@ import (
 type StatusBarView struct {
 	helpController *controller.Help
 	jobsController *controller.Jobs
+	logsController *controller.Logs
 	initialization bool
 	content        string
 	width          int
 	spinner        spinner.Model
 }
 
-func NewStatusBarView(JobsController *controller.Jobs, helpController *controller.Help) StatusBarView {
-	return StatusBarView{jobsController: JobsController, helpController: helpController,
+func NewStatusBarView(JobsController *controller.Jobs, helpController *controller.Help, logsController *controller.Logs) StatusBarView {
+	return StatusBarView{jobsController: JobsController, helpController: helpController, logsController: logsController,
 		spinner: spinner.New(spinner.WithSpinner(spinner.MiniDot))}
 }
 
@@ -43,6 +45,9 @@ func (v StatusBarView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if runningCount > 0 {
 
 		line := " " + v.spinner.View() + fmt.Sprintf(" running %d jobs, press ctrl+j to see list of jobs ", runningCount)
+		if eta := v.jobsController.ETA(); eta > 0 {
+			line = " " + v.spinner.View() + fmt.Sprintf(" running %d jobs, eta %s, press ctrl+j to see list of jobs ", runningCount, formatETA(eta))
+		}
 		w += len(line)
 		helpLines = append(helpLines, style.JobsStatusStyle.Render(line))
 	} else if v.initialization {
@@ -51,6 +56,18 @@ func (v StatusBarView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		helpLines = append(helpLines, style.JobsStatusStyle.Render(line))
 	}
 
+	if v.logsController != nil {
+		if count := v.logsController.Count(golang.LogLevel_LOG_LEVEL_WARN); count > 0 {
+			line := fmt.Sprintf(" %d plugin warnings, press ctrl+l to see plugin logs ", count)
+			w += len(line)
+			helpLines = append(helpLines, style.ErrorStatusStyle.Render(line))
+		} else if v.logsController.Count(golang.LogLevel_LOG_LEVEL_DEBUG) > 0 {
+			line := " press ctrl+l to see plugin logs "
+			w += len(line)
+			helpLines = append(helpLines, style.InfoStatusStyle2.Render(line))
+		}
+	}
+
 	for idx, line := range v.helpController.Help() {
 		line = fmt.Sprintf(" %s ", line)
 		w += len(line)